@@ -42,8 +42,17 @@ func TestAccContentfulAPIKey_Basic(t *testing.T) {
 						"name":        fmt.Sprintf("%s-updated", name),
 						"description": fmt.Sprintf("%s-updated", description),
 					}),
+					resource.TestCheckResourceAttr("contentful_apikey.myapikey", "environments.#", "1"),
+					resource.TestCheckResourceAttr("contentful_apikey.myapikey", "environments.0", "master"),
+					resource.TestCheckResourceAttrSet("contentful_apikey.myapikey", "preview_token"),
 				),
 			},
+			{
+				ResourceName:      "contentful_apikey.myapikey",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFunc("contentful_apikey.myapikey", "space_id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -65,7 +74,7 @@ func testAccCheckContentfulAPIKeyExists(n string, apiKey *contentful.APIKey) res
 			return fmt.Errorf("no api key ID is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerClient)
 
 		contentfulAPIKey, err := client.APIKeys.Get(context.Background(), spaceID, apiKeyID)
 		if err != nil {
@@ -111,7 +120,7 @@ func testAccContentfulAPIKeyDestroy(s *terraform.State) error {
 			return fmt.Errorf("no apikey ID is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerClient)
 
 		_, err := client.APIKeys.Get(context.Background(), spaceID, apiKeyID)
 		if _, ok := err.(contentful.NotFoundError); ok {
@@ -142,6 +151,7 @@ resource "contentful_apikey" "myapikey" {
 
   name = "%s-updated"
   description = "%s-updated"
+  environments = ["master"]
 }
 `, spaceID, name, description)
 }
@@ -36,6 +36,13 @@ func TestAccContentfulAsset_Basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:            "contentful_asset.myasset",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportStateIDFunc("contentful_asset.myasset", "space_id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"fields", "locale", "published", "archived"},
+			},
 		},
 	})
 }
@@ -52,7 +59,7 @@ func testAccCheckContentfulAssetExists(n string, asset *contentful.Asset) resour
 			return fmt.Errorf("no space_id is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerClient)
 
 		contentfulAsset, err := client.Assets.Get(context.Background(), spaceID, rs.Primary.ID)
 		if err != nil {
@@ -94,7 +101,7 @@ func testAccContentfulAssetDestroy(s *terraform.State) error {
 		}
 
 		// sdk client
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerClient)
 
 		asset, _ := client.Assets.Get(context.Background(), spaceID, rs.Primary.ID)
 		if asset == nil {
@@ -48,6 +48,13 @@ func TestAccContentfulContentType_Basic(t *testing.T) {
 					testAccCheckContentfulContentTypeExists("contentful_contenttype.content_type_with_id", &contentType),
 				),
 			},
+			{
+				ResourceName:            "contentful_contenttype.mycontenttype",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportStateIDFunc("contentful_contenttype.mycontenttype", "space_id", "env_id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_type_id"},
+			},
 		},
 	})
 }
@@ -107,7 +114,7 @@ func testAccCheckContentfulContentTypeExists(n string, contentType *contentful.C
 			return fmt.Errorf("no env_id is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerClient)
 
 		env := &contentful.Environment{
 			Sys: &contentful.Sys{
@@ -147,7 +154,7 @@ func testAccCheckContentfulContentTypeDestroy(s *terraform.State) (err error) {
 			return fmt.Errorf("no env_id is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerClient)
 
 		env := &contentful.Environment{
 			Sys: &contentful.Sys{
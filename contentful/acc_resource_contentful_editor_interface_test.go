@@ -0,0 +1,76 @@
+package contentful
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccContentfulEditorInterface_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContentfulContentTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulEditorInterfaceConfig("Shown below the title"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_editor_interface.myeditorinterface", "controls.0.widget_id", "singleLine"),
+					resource.TestCheckResourceAttr("contentful_editor_interface.myeditorinterface", "controls.0.help_text", "Shown below the title"),
+					resource.TestCheckResourceAttr("contentful_editor_interface.myeditorinterface", "controls.1.widget_id", "slugEditor"),
+				),
+			},
+			{
+				Config: testAccContentfulEditorInterfaceConfig("Changed help text"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_editor_interface.myeditorinterface", "controls.0.help_text", "Changed help text"),
+				),
+			},
+			{
+				ResourceName:      "contentful_editor_interface.myeditorinterface",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFunc("contentful_editor_interface.myeditorinterface", "space_id", "env_id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccContentfulEditorInterfaceConfig(helpText string) string {
+	return `
+resource "contentful_contenttype" "mycontenttype" {
+	space_id = "` + spaceID + `"
+	env_id = "` + envID + `"
+	name = "tf_editor_interface"
+	display_field = "title"
+	field {
+		id        = "title"
+		name      = "Title"
+		required  = true
+		type      = "Symbol"
+	}
+	field {
+		id        = "slug"
+		name      = "Slug"
+		required  = true
+		type      = "Symbol"
+	}
+}
+
+resource "contentful_editor_interface" "myeditorinterface" {
+	space_id = "` + spaceID + `"
+	env_id = "` + envID + `"
+	content_type_id = contentful_contenttype.mycontenttype.id
+	controls {
+		field_id  = "title"
+		widget_id = "singleLine"
+		help_text = "` + helpText + `"
+	}
+	controls {
+		field_id  = "slug"
+		widget_id = "slugEditor"
+		settings  = jsonencode({ trackingFieldId = "title" })
+	}
+}
+`
+}
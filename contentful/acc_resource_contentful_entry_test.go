@@ -36,6 +36,53 @@ func TestAccContentfulEntry_Basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:            "contentful_entry.myentry",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportStateIDFunc("contentful_entry.myentry", "space_id", "env_id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"locale"},
+			},
+		},
+	})
+}
+
+// TestAccContentfulEntry_FakeServer runs the entry lifecycle against the
+// in-memory fake of the Management API, so neither a Contentful account nor
+// TF_ACC is needed.
+func TestAccContentfulEntry_FakeServer(t *testing.T) {
+	server := newFakeServer(t)
+	server.addSpace("fake-space")
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: server.providers(t),
+		CheckDestroy: func(s *terraform.State) error {
+			if obj := server.object("spaces/fake-space/environments/master/entries/mytestentry"); obj != nil {
+				return fmt.Errorf("entry still exists: %v", obj)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulEntryFakeConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "published", "true"),
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "field.0.content", "Hello, World!"),
+				),
+			},
+			{
+				Config: testAccContentfulEntryFakeConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_entry.myentry", "published", "false"),
+				),
+			},
+			{
+				ResourceName:            "contentful_entry.myentry",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportStateIDFunc("contentful_entry.myentry", "space_id", "env_id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"locale"},
+			},
 		},
 	})
 }
@@ -67,7 +114,7 @@ func testAccCheckContentfulEntryExists(n string, entry *contentful.Entry) resour
 			return fmt.Errorf("no contenttype_id is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerClient)
 
 		contentfulEntry, err := client.Entries.Get(context.Background(), env, rs.Primary.ID)
 		if err != nil {
@@ -120,7 +167,7 @@ func testAccContentfulEntryDestroy(s *terraform.State) error {
 		}
 
 		// sdk client
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerClient)
 
 		entry, _ := client.Entries.Get(context.Background(), env, rs.Primary.ID)
 		if entry == nil {
@@ -230,3 +277,35 @@ resource "contentful_entry" "myentry" {
   depends_on = [contentful_contenttype.mycontenttype]
 }
 `
+
+func testAccContentfulEntryFakeConfig(published bool) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id      = "fake-space"
+  env_id        = "master"
+  name          = "tf_test_1"
+  display_field = "field1"
+  field {
+    id       = "field1"
+    name     = "Field 1"
+    required = true
+    type     = "Text"
+  }
+}
+
+resource "contentful_entry" "myentry" {
+  entry_id       = "mytestentry"
+  space_id       = "fake-space"
+  env_id         = "master"
+  contenttype_id = contentful_contenttype.mycontenttype.id
+  locale         = "en-US"
+  field {
+    id      = "field1"
+    content = "Hello, World!"
+    locale  = "en-US"
+  }
+  published = %t
+  archived  = false
+}
+`, published)
+}
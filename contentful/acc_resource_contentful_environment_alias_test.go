@@ -0,0 +1,110 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	contentful "github.com/kitagry/contentful-go"
+)
+
+func TestAccContentfulEnvironmentAlias_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulEnvironmentAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulEnvironmentAliasConfig("blue"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("contentful_environment_alias.myalias", "alias_id", "provider-test-alias"),
+					testAccCheckContentfulEnvironmentAliasTarget("contentful_environment_alias.myalias", "provider-test-blue"),
+				),
+			},
+			{
+				Config: testAccContentfulEnvironmentAliasConfig("green"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContentfulEnvironmentAliasTarget("contentful_environment_alias.myalias", "provider-test-green"),
+				),
+			},
+			{
+				ResourceName:      "contentful_environment_alias.myalias",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFunc("contentful_environment_alias.myalias", "space_id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulEnvironmentAliasTarget(n, environmentID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not Found: %s", n)
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		if spaceID == "" {
+			return fmt.Errorf("no space_id is set")
+		}
+
+		client := testAccProvider.Meta().(*providerClient)
+
+		alias, err := client.environmentAliases.Get(context.Background(), spaceID, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if alias.Alias == nil || alias.Alias.Sys == nil || alias.Alias.Sys.ID != environmentID {
+			return fmt.Errorf("alias does not point to %s", environmentID)
+		}
+
+		return nil
+	}
+}
+
+func testAccContentfulEnvironmentAliasDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_environment_alias" {
+			continue
+		}
+		spaceID := rs.Primary.Attributes["space_id"]
+		if spaceID == "" {
+			return fmt.Errorf("no space_id is set")
+		}
+
+		client := testAccProvider.Meta().(*providerClient)
+
+		_, err := client.environmentAliases.Get(context.Background(), spaceID, rs.Primary.ID)
+		if _, ok := err.(contentful.NotFoundError); ok {
+			return nil
+		}
+
+		return fmt.Errorf("environment alias still exists with id: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccContentfulEnvironmentAliasConfig(target string) string {
+	return `
+resource "contentful_environment" "blue" {
+  space_id = "` + spaceID + `"
+  name = "provider-test-blue"
+}
+
+resource "contentful_environment" "green" {
+  space_id = "` + spaceID + `"
+  name = "provider-test-green"
+}
+
+resource "contentful_environment_alias" "myalias" {
+  space_id = "` + spaceID + `"
+  alias_id = "provider-test-alias"
+  environment_id = contentful_environment.` + target + `.id
+}
+`
+}
@@ -38,6 +38,12 @@ func TestAccContentfulEnvironment_Basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:      "contentful_environment.myenvironment",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFunc("contentful_environment.myenvironment", "space_id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -59,7 +65,7 @@ func testAccCheckContentfulEnvironmentExists(n string, environment *contentful.E
 			return fmt.Errorf("no name is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerClient)
 
 		contentfulEnvironment, err := client.Environments.Get(context.Background(), spaceID, rs.Primary.ID)
 		if err != nil {
@@ -98,7 +104,7 @@ func testAccContentfulEnvironmentDestroy(s *terraform.State) error {
 			return fmt.Errorf("no locale ID is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerClient)
 
 		_, err := client.Locales.Get(context.Background(), spaceID, localeID)
 		if _, ok := err.(contentful.NotFoundError); ok {
@@ -48,6 +48,12 @@ func TestAccContentfulLocales_Basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:      "contentful_locale.mylocale",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFunc("contentful_locale.mylocale", "space_id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -69,7 +75,7 @@ func testAccCheckContentfulLocaleExists(n string, locale *contentful.Locale) res
 			return fmt.Errorf("no locale ID is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerClient)
 
 		contentfulLocale, err := client.Locales.Get(context.Background(), spaceID, localeID)
 		if err != nil {
@@ -134,7 +140,7 @@ func testAccContentfulLocaleDestroy(s *terraform.State) error {
 			return fmt.Errorf("no locale ID is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerClient)
 
 		locale, _ := client.Locales.Get(context.Background(), spaceID, localeID)
 
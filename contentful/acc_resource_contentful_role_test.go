@@ -0,0 +1,123 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	contentful "github.com/kitagry/contentful-go"
+)
+
+func TestAccContentfulRole_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccContentfulRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentfulRoleConfig("provider-test-role", "read"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContentfulRoleExists("contentful_role.myrole"),
+					resource.TestCheckResourceAttr("contentful_role.myrole", "name", "provider-test-role"),
+					resource.TestCheckResourceAttr("contentful_role.myrole", "policy.#", "2"),
+				),
+			},
+			{
+				Config: testAccContentfulRoleConfig("provider-test-role-updated", "all"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContentfulRoleExists("contentful_role.myrole"),
+					resource.TestCheckResourceAttr("contentful_role.myrole", "name", "provider-test-role-updated"),
+					resource.TestCheckResourceAttr("contentful_role.myrole", "policy.0.actions.0", "all"),
+				),
+			},
+			{
+				ResourceName:      "contentful_role.myrole",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFunc("contentful_role.myrole", "space_id"),
+				ImportStateVerify: true,
+				// The constraint is imported as returned by the API.
+				ImportStateVerifyIgnore: []string{"policy.0.constraint", "policy.1.constraint"},
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulRoleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not Found: %s", n)
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		if spaceID == "" {
+			return fmt.Errorf("no space_id is set")
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no role ID is set")
+		}
+
+		client := testAccProvider.Meta().(*providerClient)
+
+		_, err := client.roles.Get(context.Background(), spaceID, rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccContentfulRoleDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_role" {
+			continue
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		if spaceID == "" {
+			return fmt.Errorf("no space_id is set")
+		}
+
+		client := testAccProvider.Meta().(*providerClient)
+
+		_, err := client.roles.Get(context.Background(), spaceID, rs.Primary.ID)
+		if _, ok := err.(contentful.NotFoundError); ok {
+			return nil
+		}
+
+		return fmt.Errorf("role still exists with id: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccContentfulRoleConfig(name, action string) string {
+	return `
+resource "contentful_role" "myrole" {
+  space_id = "` + spaceID + `"
+
+  name        = "` + name + `"
+  description = "Created by the provider tests"
+
+  permissions {
+    content_model = ["read"]
+  }
+
+  policy {
+    effect  = "allow"
+    actions = ["` + action + `"]
+    constraint = jsonencode({
+      equals = [{ doc = "sys.type" }, "Entry"]
+    })
+  }
+
+  policy {
+    effect  = "deny"
+    actions = ["publish"]
+    constraint = jsonencode({
+      equals = [{ doc = "sys.type" }, "Asset"]
+    })
+  }
+}
+`
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	contentful "github.com/kitagry/contentful-go"
 )
 
 func TestAccContentfulSpace_Basic(t *testing.T) {
@@ -27,12 +26,18 @@ func TestAccContentfulSpace_Basic(t *testing.T) {
 				Check: resource.TestCheckResourceAttr(
 					"contentful_space.myspace", "name", "TF Acc Test Changed Space"),
 			},
+			{
+				ResourceName:            "contentful_space.myspace",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"default_locale"},
+			},
 		},
 	})
 }
 
 func testAccCheckContentfulSpaceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*contentful.Client)
+	client := testAccProvider.Meta().(*providerClient)
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "contentful_space" {
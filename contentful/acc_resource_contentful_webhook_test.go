@@ -11,7 +11,7 @@ import (
 )
 
 func TestAccContentfulWebhook_Basic(t *testing.T) {
-	var webhook contentful.Webhook
+	var webhook Webhook
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -42,11 +42,18 @@ func TestAccContentfulWebhook_Basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:            "contentful_webhook.mywebhook",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportStateIDFunc("contentful_webhook.mywebhook", "space_id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"http_basic_auth_password", "secret_headers"},
+			},
 		},
 	})
 }
 
-func testAccCheckContentfulWebhookExists(n string, webhook *contentful.Webhook) resource.TestCheckFunc {
+func testAccCheckContentfulWebhookExists(n string, webhook *Webhook) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -64,9 +71,9 @@ func testAccCheckContentfulWebhookExists(n string, webhook *contentful.Webhook)
 			return fmt.Errorf("no webhook ID is set")
 		}
 
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerClient)
 
-		contentfulWebhook, err := client.Webhooks.Get(context.Background(), spaceID, rs.Primary.ID)
+		contentfulWebhook, err := client.webhooks.Get(context.Background(), spaceID, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -77,7 +84,7 @@ func testAccCheckContentfulWebhookExists(n string, webhook *contentful.Webhook)
 	}
 }
 
-func testAccCheckContentfulWebhookAttributes(webhook *contentful.Webhook, attrs map[string]interface{}) resource.TestCheckFunc {
+func testAccCheckContentfulWebhookAttributes(webhook *Webhook, attrs map[string]interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		name := attrs["name"].(string)
 		if webhook.Name != name {
@@ -120,9 +127,9 @@ func testAccContentfulWebhookDestroy(s *terraform.State) error {
 		}
 
 		// sdk client
-		client := testAccProvider.Meta().(*contentful.Client)
+		client := testAccProvider.Meta().(*providerClient)
 
-		_, err := client.Webhooks.Get(context.Background(), spaceID, rs.Primary.ID)
+		_, err := client.webhooks.Get(context.Background(), spaceID, rs.Primary.ID)
 		if _, ok := err.(contentful.NotFoundError); ok {
 			return nil
 		}
@@ -168,6 +175,9 @@ resource "contentful_webhook" "mywebhook" {
 	header1 = "header1-value-updated"
     header2 = "header2-value-updated"
   }
+  secret_headers = {
+    Authorization = "Bearer secret"
+  }
   http_basic_auth_username = "username-updated"
   http_basic_auth_password = "password-updated"
 }
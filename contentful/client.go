@@ -0,0 +1,179 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	contentful "github.com/kitagry/contentful-go"
+)
+
+const (
+	defaultBaseURL   = "https://api.contentful.com"
+	defaultUploadURL = "https://upload.contentful.com"
+)
+
+// providerClient is the meta passed to every resource. It embeds the client for
+// the Content Management API, so its services can be used directly, and holds
+// the client for the Upload API next to it.
+type providerClient struct {
+	*contentful.Client
+
+	upload         *contentful.Client
+	httpClient     *http.Client
+	organizationID string
+
+	// Services for endpoints which contentful-go doesn't support (fully).
+	apiKeys              *apiKeysService
+	assets               *assetsService
+	editorInterfaces     *editorInterfacesService
+	environments         *environmentsService
+	entries              *entriesService
+	environmentAliases   *environmentAliasesService
+	locales              *localesService
+	roles                *rolesService
+	spaceMemberships     *spaceMembershipsService
+	teamSpaceMemberships *teamSpaceMembershipsService
+	webhooks             *webhooksService
+}
+
+// clientConfig holds the provider arguments which affect how requests are made.
+type clientConfig struct {
+	token          string
+	organizationID string
+	baseURL        string
+	uploadURL      string
+	userAgent      string
+	timeout        time.Duration
+	proxyURL       string
+	debug          bool
+
+	maxRetries       int
+	maxRatePerSecond int
+}
+
+// newProviderClient creates the API clients. All of them share one http.Client,
+// so the timeout, proxy, retry and rate limit settings apply to every request
+// the provider makes.
+func newProviderClient(config clientConfig) (*providerClient, error) {
+	httpClient, err := newHTTPClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	cma := contentful.NewCMA(config.token)
+	cma.SetOrganization(config.organizationID)
+	cma.BaseURL = config.baseURL
+
+	upload := contentful.NewResourceClient(config.token)
+	upload.BaseURL = config.uploadURL
+
+	for _, c := range []*contentful.Client{cma, upload} {
+		c.SetHTTPClient(httpClient)
+		c.Debug = config.debug
+		if config.userAgent != "" {
+			c.Headers["User-Agent"] = config.userAgent
+		}
+	}
+
+	c := &providerClient{
+		Client:         cma,
+		upload:         upload,
+		httpClient:     httpClient,
+		organizationID: config.organizationID,
+	}
+	c.apiKeys = &apiKeysService{APIKeyService: cma.APIKeys, c: c}
+	c.assets = &assetsService{c: c}
+	c.editorInterfaces = &editorInterfacesService{c: c}
+	c.entries = &entriesService{c: c}
+	c.environments = &environmentsService{EnvironmentsService: cma.Environments, c: c}
+	c.environmentAliases = &environmentAliasesService{EnvironmentAliasesService: cma.EnvironmentAliases, c: c}
+	c.locales = &localesService{c: c}
+	c.roles = &rolesService{c: c}
+	c.spaceMemberships = &spaceMembershipsService{c: c}
+	c.teamSpaceMemberships = &teamSpaceMembershipsService{c: c}
+	c.webhooks = &webhooksService{c: c}
+
+	return c, nil
+}
+
+func newHTTPClient(config clientConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.proxyURL != "" {
+		proxy, err := url.Parse(config.proxyURL)
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	return &http.Client{
+		// The timeout is applied to every attempt by the transport instead
+		// of http.Client.Timeout, which would also count retries and the
+		// backoff between them.
+		Transport: newRetryTransport(transport, config.maxRetries, config.maxRatePerSecond, config.timeout),
+	}, nil
+}
+
+// newRequest creates a request to the Content Management API with the same
+// headers as the requests of contentful-go. body is encoded as JSON.
+func (c *providerClient) newRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
+	u, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = path
+
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range c.Headers {
+		req.Header.Set(key, value)
+	}
+
+	return req, nil
+}
+
+// do sends the request and decodes the response into v. Errors are returned as
+// contentful.NotFoundError for 404 and as contentful.ErrorResponse otherwise,
+// so that they can be handled like the errors of contentful-go.
+func (c *providerClient) do(req *http.Request, v interface{}) error {
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		if v == nil || res.StatusCode == http.StatusNoContent {
+			return nil
+		}
+		return json.NewDecoder(res.Body).Decode(v)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return contentful.NotFoundError{}
+	}
+
+	var e contentful.ErrorResponse
+	if err := json.NewDecoder(res.Body).Decode(&e); err != nil || e.Message == "" {
+		e.Message = res.Status
+	}
+	return e
+}
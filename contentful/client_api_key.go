@@ -0,0 +1,73 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	contentful "github.com/kitagry/contentful-go"
+)
+
+// PreviewAPIKey is the key for the Content Preview API which Contentful
+// creates together with every API key.
+type PreviewAPIKey struct {
+	Sys         *contentful.Sys `json:"sys,omitempty"`
+	AccessToken string          `json:"accessToken,omitempty"`
+}
+
+// apiKeysService extends the APIKeyService of contentful-go, which only sends
+// the name and the description of a key and can't read preview keys.
+type apiKeysService struct {
+	*contentful.APIKeyService
+	c *providerClient
+}
+
+type apiKeyBody struct {
+	Name         string                    `json:"name"`
+	Description  string                    `json:"description,omitempty"`
+	Environments []contentful.Environments `json:"environments,omitempty"`
+}
+
+// Upsert creates the API key or updates it if it already exists. The
+// environments are only sent if apiKey has any, so that Contentful assigns
+// its default otherwise.
+func (s *apiKeysService) Upsert(ctx context.Context, spaceID string, apiKey *contentful.APIKey) error {
+	method := http.MethodPost
+	path := fmt.Sprintf("/spaces/%s/api_keys", spaceID)
+	if apiKey.Sys != nil && apiKey.Sys.CreatedAt != "" {
+		method = http.MethodPut
+		path = fmt.Sprintf("/spaces/%s/api_keys/%s", spaceID, apiKey.Sys.ID)
+	}
+
+	req, err := s.c.newRequest(ctx, method, path, &apiKeyBody{
+		Name:         apiKey.Name,
+		Description:  apiKey.Description,
+		Environments: apiKey.Environments,
+	})
+	if err != nil {
+		return err
+	}
+
+	if method == http.MethodPut {
+		req.Header.Set("X-Contentful-Version", strconv.Itoa(apiKey.GetVersion()))
+	}
+
+	return s.c.do(req, apiKey)
+}
+
+// GetPreviewAPIKey returns the preview key with the given ID.
+func (s *apiKeysService) GetPreviewAPIKey(ctx context.Context, spaceID, previewAPIKeyID string) (*PreviewAPIKey, error) {
+	path := fmt.Sprintf("/spaces/%s/preview_api_keys/%s", spaceID, previewAPIKeyID)
+	req, err := s.c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var previewAPIKey PreviewAPIKey
+	if err := s.c.do(req, &previewAPIKey); err != nil {
+		return nil, err
+	}
+
+	return &previewAPIKey, nil
+}
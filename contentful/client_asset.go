@@ -0,0 +1,137 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	contentful "github.com/kitagry/contentful-go"
+)
+
+// Upload is a file uploaded to the Upload API. It can be linked as the
+// uploadFrom of an asset file until the asset is processed.
+type Upload struct {
+	Sys *contentful.Sys `json:"sys"`
+}
+
+// assetsService replaces the AssetsService of contentful-go, which only
+// supports the assets of the master environment. The ResourcesService of
+// contentful-go doesn't return the created upload, so uploads are created
+// here as well.
+type assetsService struct {
+	c *providerClient
+}
+
+func assetPath(env *contentful.Environment, assetID string) string {
+	return fmt.Sprintf("/spaces/%s/environments/%s/assets/%s", env.Sys.Space.Sys.ID, env.Sys.ID, assetID)
+}
+
+// Get returns the asset of the environment.
+func (s *assetsService) Get(ctx context.Context, env *contentful.Environment, assetID string) (*contentful.Asset, error) {
+	req, err := s.c.newRequest(ctx, http.MethodGet, assetPath(env, assetID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var asset contentful.Asset
+	if err := s.c.do(req, &asset); err != nil {
+		return nil, err
+	}
+
+	return &asset, nil
+}
+
+// Upsert creates the asset with the ID of its sys, or updates it if it was
+// created before.
+func (s *assetsService) Upsert(ctx context.Context, env *contentful.Environment, asset *contentful.Asset) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/assets", env.Sys.Space.Sys.ID, env.Sys.ID)
+	method := http.MethodPost
+	if asset.Sys != nil && asset.Sys.ID != "" {
+		path = assetPath(env, asset.Sys.ID)
+		method = http.MethodPut
+	}
+
+	req, err := s.c.newRequest(ctx, method, path, asset)
+	if err != nil {
+		return err
+	}
+
+	if asset.Sys != nil && asset.Sys.CreatedAt != "" {
+		req.Header.Set("X-Contentful-Version", strconv.Itoa(asset.Sys.Version))
+	}
+
+	return s.c.do(req, asset)
+}
+
+// Delete deletes the asset.
+func (s *assetsService) Delete(ctx context.Context, env *contentful.Environment, asset *contentful.Asset) error {
+	return s.send(ctx, http.MethodDelete, assetPath(env, asset.Sys.ID), asset, nil)
+}
+
+// Process starts processing the file of the locale.
+func (s *assetsService) Process(ctx context.Context, env *contentful.Environment, asset *contentful.Asset, locale string) error {
+	return s.send(ctx, http.MethodPut, assetPath(env, asset.Sys.ID)+"/files/"+locale+"/process", asset, nil)
+}
+
+// Publish publishes the asset.
+func (s *assetsService) Publish(ctx context.Context, env *contentful.Environment, asset *contentful.Asset) error {
+	return s.send(ctx, http.MethodPut, assetPath(env, asset.Sys.ID)+"/published", asset, asset)
+}
+
+// Unpublish unpublishes the asset.
+func (s *assetsService) Unpublish(ctx context.Context, env *contentful.Environment, asset *contentful.Asset) error {
+	return s.send(ctx, http.MethodDelete, assetPath(env, asset.Sys.ID)+"/published", asset, asset)
+}
+
+// Archive archives the asset.
+func (s *assetsService) Archive(ctx context.Context, env *contentful.Environment, asset *contentful.Asset) error {
+	return s.send(ctx, http.MethodPut, assetPath(env, asset.Sys.ID)+"/archived", asset, asset)
+}
+
+// Unarchive unarchives the asset.
+func (s *assetsService) Unarchive(ctx context.Context, env *contentful.Environment, asset *contentful.Asset) error {
+	return s.send(ctx, http.MethodDelete, assetPath(env, asset.Sys.ID)+"/archived", asset, asset)
+}
+
+// send makes a request without body for the current version of the asset
+// and decodes the response into v.
+func (s *assetsService) send(ctx context.Context, method, path string, asset *contentful.Asset, v interface{}) error {
+	req, err := s.c.newRequest(ctx, method, path, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(asset.Sys.Version))
+
+	return s.c.do(req, v)
+}
+
+// CreateUpload uploads content to the Upload API. Uploads belong to the space
+// of the environment.
+func (s *assetsService) CreateUpload(ctx context.Context, env *contentful.Environment, content io.Reader) (*Upload, error) {
+	u, err := url.Parse(s.c.upload.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = fmt.Sprintf("/spaces/%s/uploads", env.Sys.Space.Sys.ID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), content)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range s.c.upload.Headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	var upload Upload
+	if err := s.c.do(req, &upload); err != nil {
+		return nil, err
+	}
+
+	return &upload, nil
+}
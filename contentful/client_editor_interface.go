@@ -0,0 +1,93 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	contentful "github.com/kitagry/contentful-go"
+)
+
+// EditorInterface is the editor interface of a content type. The model of
+// contentful-go lacks the editor layout and only supports string settings.
+type EditorInterface struct {
+	Sys           *contentful.Sys               `json:"sys,omitempty"`
+	Controls      []EditorInterfaceControl      `json:"controls,omitempty"`
+	Sidebar       []EditorInterfaceSidebarItem  `json:"sidebar,omitempty"`
+	EditorLayout  []EditorLayoutItem            `json:"editorLayout,omitempty"`
+	GroupControls []EditorInterfaceGroupControl `json:"groupControls,omitempty"`
+}
+
+// EditorInterfaceControl configures the widget of a field.
+type EditorInterfaceControl struct {
+	FieldID         string                 `json:"fieldId"`
+	WidgetNamespace string                 `json:"widgetNamespace,omitempty"`
+	WidgetID        string                 `json:"widgetId,omitempty"`
+	Settings        map[string]interface{} `json:"settings,omitempty"`
+}
+
+// EditorInterfaceSidebarItem is a widget of the entry sidebar.
+type EditorInterfaceSidebarItem struct {
+	WidgetNamespace string                 `json:"widgetNamespace"`
+	WidgetID        string                 `json:"widgetId"`
+	Settings        map[string]interface{} `json:"settings,omitempty"`
+	Disabled        bool                   `json:"disabled,omitempty"`
+}
+
+// EditorLayoutItem is either a field or a group of fields in the editor layout.
+type EditorLayoutItem struct {
+	FieldID string             `json:"fieldId,omitempty"`
+	GroupID string             `json:"groupId,omitempty"`
+	Name    string             `json:"name,omitempty"`
+	Items   []EditorLayoutItem `json:"items,omitempty"`
+}
+
+// EditorInterfaceGroupControl configures the widget of an editor layout group.
+type EditorInterfaceGroupControl struct {
+	GroupID         string                 `json:"groupId"`
+	WidgetNamespace string                 `json:"widgetNamespace,omitempty"`
+	WidgetID        string                 `json:"widgetId,omitempty"`
+	Settings        map[string]interface{} `json:"settings,omitempty"`
+}
+
+// editorInterfacesService replaces the EditorInterfacesService of contentful-go,
+// which always uses the environment of the client, doesn't send the version on
+// updates and swallows request errors.
+type editorInterfacesService struct {
+	c *providerClient
+}
+
+func editorInterfacePath(env *contentful.Environment, contentTypeID string) string {
+	return fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/editor_interface", env.Sys.Space.Sys.ID, env.Sys.ID, contentTypeID)
+}
+
+// Get returns the editor interface of the content type.
+func (s *editorInterfacesService) Get(ctx context.Context, env *contentful.Environment, contentTypeID string) (*EditorInterface, error) {
+	req, err := s.c.newRequest(ctx, http.MethodGet, editorInterfacePath(env, contentTypeID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ei EditorInterface
+	if err := s.c.do(req, &ei); err != nil {
+		return nil, err
+	}
+
+	return &ei, nil
+}
+
+// Update replaces the editor interface of the content type. ei has to carry
+// the current version in its sys.
+func (s *editorInterfacesService) Update(ctx context.Context, env *contentful.Environment, contentTypeID string, ei *EditorInterface) error {
+	req, err := s.c.newRequest(ctx, http.MethodPut, editorInterfacePath(env, contentTypeID), ei)
+	if err != nil {
+		return err
+	}
+
+	if ei.Sys != nil {
+		req.Header.Set("X-Contentful-Version", strconv.Itoa(ei.Sys.Version))
+	}
+
+	return s.c.do(req, ei)
+}
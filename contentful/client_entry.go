@@ -0,0 +1,102 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	contentful "github.com/kitagry/contentful-go"
+)
+
+// entriesService replaces the EntriesService of contentful-go, whose Get
+// returns a nil entry without an error when the request fails, and whose
+// state changes don't return the new version of the entry.
+type entriesService struct {
+	c *providerClient
+}
+
+func entryPath(env *contentful.Environment, entryID string) string {
+	return fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", env.Sys.Space.Sys.ID, env.Sys.ID, entryID)
+}
+
+// Get returns the entry of the environment.
+func (s *entriesService) Get(ctx context.Context, env *contentful.Environment, entryID string) (*contentful.Entry, error) {
+	req, err := s.c.newRequest(ctx, http.MethodGet, entryPath(env, entryID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var entry contentful.Entry
+	if err := s.c.do(req, &entry); err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// Upsert creates the entry with the ID of its sys, or updates it if it was
+// created before.
+func (s *entriesService) Upsert(ctx context.Context, env *contentful.Environment, contentTypeID string, entry *contentful.Entry) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries", env.Sys.Space.Sys.ID, env.Sys.ID)
+	method := http.MethodPost
+	if entry.Sys != nil && entry.Sys.ID != "" {
+		path = entryPath(env, entry.Sys.ID)
+		method = http.MethodPut
+	}
+
+	req, err := s.c.newRequest(ctx, method, path, entry)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Content-Type", contentTypeID)
+	if entry.Sys != nil && entry.Sys.CreatedAt != "" {
+		req.Header.Set("X-Contentful-Version", strconv.Itoa(entry.Sys.Version))
+	}
+
+	return s.c.do(req, entry)
+}
+
+// Delete deletes the entry.
+func (s *entriesService) Delete(ctx context.Context, env *contentful.Environment, entryID string) error {
+	req, err := s.c.newRequest(ctx, http.MethodDelete, entryPath(env, entryID), nil)
+	if err != nil {
+		return err
+	}
+
+	return s.c.do(req, nil)
+}
+
+// Publish publishes the entry.
+func (s *entriesService) Publish(ctx context.Context, env *contentful.Environment, entry *contentful.Entry) error {
+	return s.send(ctx, http.MethodPut, entryPath(env, entry.Sys.ID)+"/published", entry)
+}
+
+// Unpublish unpublishes the entry.
+func (s *entriesService) Unpublish(ctx context.Context, env *contentful.Environment, entry *contentful.Entry) error {
+	return s.send(ctx, http.MethodDelete, entryPath(env, entry.Sys.ID)+"/published", entry)
+}
+
+// Archive archives the entry.
+func (s *entriesService) Archive(ctx context.Context, env *contentful.Environment, entry *contentful.Entry) error {
+	return s.send(ctx, http.MethodPut, entryPath(env, entry.Sys.ID)+"/archived", entry)
+}
+
+// Unarchive unarchives the entry.
+func (s *entriesService) Unarchive(ctx context.Context, env *contentful.Environment, entry *contentful.Entry) error {
+	return s.send(ctx, http.MethodDelete, entryPath(env, entry.Sys.ID)+"/archived", entry)
+}
+
+// send makes a request without body for the current version of the entry
+// and decodes the response into the entry.
+func (s *entriesService) send(ctx context.Context, method, path string, entry *contentful.Entry) error {
+	req, err := s.c.newRequest(ctx, method, path, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(entry.Sys.Version))
+
+	return s.c.do(req, entry)
+}
@@ -0,0 +1,95 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	contentful "github.com/kitagry/contentful-go"
+)
+
+const (
+	environmentStatusReady  = "ready"
+	environmentStatusFailed = "failed"
+)
+
+// environmentsService extends the EnvironmentsService of contentful-go, which
+// can't clone environments and drops sys.status.
+type environmentsService struct {
+	*contentful.EnvironmentsService
+	c *providerClient
+}
+
+// Create creates the environment e. If sourceEnvironmentID is set, the
+// environment is cloned from it instead of master. The clone runs
+// asynchronously, see Status.
+func (s *environmentsService) Create(ctx context.Context, spaceID string, e *contentful.Environment, sourceEnvironmentID string) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s", spaceID, e.Name)
+	req, err := s.c.newRequest(ctx, http.MethodPut, path, map[string]string{"name": e.Name})
+	if err != nil {
+		return err
+	}
+
+	if sourceEnvironmentID != "" {
+		req.Header.Set("X-Contentful-Source-Environment", sourceEnvironmentID)
+	}
+
+	return s.c.do(req, e)
+}
+
+// Status returns the sys.status of the environment, e.g. "queued",
+// "inProgress", "ready" or "failed".
+func (s *environmentsService) Status(ctx context.Context, spaceID, environmentID string) (string, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s", spaceID, environmentID)
+	req, err := s.c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var environment struct {
+		Sys struct {
+			Status struct {
+				Sys contentful.Sys `json:"sys"`
+			} `json:"status"`
+		} `json:"sys"`
+	}
+	if err := s.c.do(req, &environment); err != nil {
+		return "", err
+	}
+
+	return environment.Sys.Status.Sys.ID, nil
+}
+
+// environmentAliasesService extends the EnvironmentAliasesService of
+// contentful-go, which can only update existing aliases.
+type environmentAliasesService struct {
+	*contentful.EnvironmentAliasesService
+	c *providerClient
+}
+
+func environmentAliasPath(spaceID string, ea *contentful.EnvironmentAlias) string {
+	return fmt.Sprintf("/spaces/%s/environment_aliases/%s", spaceID, ea.Sys.ID)
+}
+
+// Create creates the alias ea.Sys.ID pointing to the environment of ea.
+func (s *environmentAliasesService) Create(ctx context.Context, spaceID string, ea *contentful.EnvironmentAlias) error {
+	req, err := s.c.newRequest(ctx, http.MethodPut, environmentAliasPath(spaceID, ea), ea)
+	if err != nil {
+		return err
+	}
+
+	return s.c.do(req, ea)
+}
+
+// Delete deletes the alias. The master alias can't be deleted.
+func (s *environmentAliasesService) Delete(ctx context.Context, spaceID string, ea *contentful.EnvironmentAlias) error {
+	req, err := s.c.newRequest(ctx, http.MethodDelete, environmentAliasPath(spaceID, ea), nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(ea.GetVersion()))
+
+	return s.c.do(req, nil)
+}
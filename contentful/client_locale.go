@@ -0,0 +1,103 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	contentful "github.com/kitagry/contentful-go"
+)
+
+// localesService replaces the LocalesService of contentful-go, which only
+// supports the locales of the space. Without an environment ID, the space
+// level endpoints are used like before.
+type localesService struct {
+	c *providerClient
+}
+
+type localeCollection struct {
+	Total int                  `json:"total"`
+	Skip  int                  `json:"skip"`
+	Items []*contentful.Locale `json:"items"`
+}
+
+func localesPath(spaceID, environmentID string) string {
+	if environmentID == "" {
+		return fmt.Sprintf("/spaces/%s/locales", spaceID)
+	}
+	return fmt.Sprintf("/spaces/%s/environments/%s/locales", spaceID, environmentID)
+}
+
+// List returns every locale of the space or environment.
+func (s *localesService) List(ctx context.Context, spaceID, environmentID string) ([]*contentful.Locale, error) {
+	var locales []*contentful.Locale
+	for {
+		req, err := s.c.newRequest(ctx, http.MethodGet, localesPath(spaceID, environmentID), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		q := req.URL.Query()
+		q.Set("skip", strconv.Itoa(len(locales)))
+		req.URL.RawQuery = q.Encode()
+
+		var col localeCollection
+		if err := s.c.do(req, &col); err != nil {
+			return nil, err
+		}
+
+		locales = append(locales, col.Items...)
+		if len(col.Items) == 0 || len(locales) >= col.Total {
+			return locales, nil
+		}
+	}
+}
+
+// Get returns the locale of the space or environment.
+func (s *localesService) Get(ctx context.Context, spaceID, environmentID, localeID string) (*contentful.Locale, error) {
+	req, err := s.c.newRequest(ctx, http.MethodGet, localesPath(spaceID, environmentID)+"/"+localeID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var locale contentful.Locale
+	if err := s.c.do(req, &locale); err != nil {
+		return nil, err
+	}
+
+	return &locale, nil
+}
+
+// Upsert creates the locale, or updates it if it was created before.
+func (s *localesService) Upsert(ctx context.Context, spaceID, environmentID string, locale *contentful.Locale) error {
+	if locale.Sys == nil || locale.Sys.CreatedAt == "" {
+		req, err := s.c.newRequest(ctx, http.MethodPost, localesPath(spaceID, environmentID), locale)
+		if err != nil {
+			return err
+		}
+
+		return s.c.do(req, locale)
+	}
+
+	req, err := s.c.newRequest(ctx, http.MethodPut, localesPath(spaceID, environmentID)+"/"+locale.Sys.ID, locale)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(locale.Sys.Version))
+
+	return s.c.do(req, locale)
+}
+
+// Delete deletes the locale.
+func (s *localesService) Delete(ctx context.Context, spaceID, environmentID string, locale *contentful.Locale) error {
+	req, err := s.c.newRequest(ctx, http.MethodDelete, localesPath(spaceID, environmentID)+"/"+locale.Sys.ID, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(locale.Sys.Version))
+
+	return s.c.do(req, nil)
+}
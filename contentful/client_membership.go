@@ -0,0 +1,204 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	contentful "github.com/kitagry/contentful-go"
+)
+
+// SpaceMembership gives a user access to a space. On creation the user is
+// identified by Email, Contentful invites the user if they aren't a member of
+// the organization yet.
+type SpaceMembership struct {
+	Sys   *contentful.Sys    `json:"sys,omitempty"`
+	Admin bool               `json:"admin"`
+	Roles []contentful.Roles `json:"roles"`
+	User  *contentful.Member `json:"user,omitempty"`
+	Email string             `json:"email,omitempty"`
+}
+
+// TeamSpaceMembership gives every member of a team access to a space.
+type TeamSpaceMembership struct {
+	Sys   *contentful.Sys    `json:"sys,omitempty"`
+	Admin bool               `json:"admin"`
+	Roles []contentful.Roles `json:"roles"`
+	Team  *contentful.Member `json:"team,omitempty"`
+}
+
+// OrganizationUser is a user of the organization of the provider.
+type OrganizationUser struct {
+	Sys       *contentful.Sys `json:"sys,omitempty"`
+	Email     string          `json:"email"`
+	FirstName string          `json:"firstName"`
+	LastName  string          `json:"lastName"`
+}
+
+// Team is a team of the organization of the provider.
+type Team struct {
+	Sys         *contentful.Sys `json:"sys,omitempty"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+}
+
+type membershipBody struct {
+	Admin bool               `json:"admin"`
+	Roles []contentful.Roles `json:"roles"`
+	Email string             `json:"email,omitempty"`
+}
+
+// spaceMembershipsService replaces the MembershipsService of contentful-go,
+// whose Get swallows errors.
+type spaceMembershipsService struct {
+	c *providerClient
+}
+
+// Get returns the space membership.
+func (s *spaceMembershipsService) Get(ctx context.Context, spaceID, membershipID string) (*SpaceMembership, error) {
+	path := fmt.Sprintf("/spaces/%s/space_memberships/%s", spaceID, membershipID)
+	req, err := s.c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var membership SpaceMembership
+	if err := s.c.do(req, &membership); err != nil {
+		return nil, err
+	}
+
+	return &membership, nil
+}
+
+// Upsert creates the space membership or updates it if it already exists. The
+// user of a membership can't be changed.
+func (s *spaceMembershipsService) Upsert(ctx context.Context, spaceID string, membership *SpaceMembership) error {
+	method := http.MethodPost
+	path := fmt.Sprintf("/spaces/%s/space_memberships", spaceID)
+	body := &membershipBody{
+		Admin: membership.Admin,
+		Roles: membership.Roles,
+		Email: membership.Email,
+	}
+	if membership.Sys != nil && membership.Sys.CreatedAt != "" {
+		method = http.MethodPut
+		path = fmt.Sprintf("/spaces/%s/space_memberships/%s", spaceID, membership.Sys.ID)
+		body.Email = ""
+	}
+
+	req, err := s.c.newRequest(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+
+	if method == http.MethodPut {
+		req.Header.Set("X-Contentful-Version", strconv.Itoa(membership.Sys.Version))
+	}
+
+	return s.c.do(req, membership)
+}
+
+// Delete deletes the space membership.
+func (s *spaceMembershipsService) Delete(ctx context.Context, spaceID string, membership *SpaceMembership) error {
+	path := fmt.Sprintf("/spaces/%s/space_memberships/%s", spaceID, membership.Sys.ID)
+	req, err := s.c.newRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.c.do(req, nil)
+}
+
+// GetUser returns the user of the organization of the provider.
+func (s *spaceMembershipsService) GetUser(ctx context.Context, userID string) (*OrganizationUser, error) {
+	path := fmt.Sprintf("/organizations/%s/users/%s", s.c.organizationID, userID)
+	req, err := s.c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var user OrganizationUser
+	if err := s.c.do(req, &user); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// teamSpaceMembershipsService manages the memberships of teams, which
+// contentful-go doesn't support.
+type teamSpaceMembershipsService struct {
+	c *providerClient
+}
+
+// Get returns the team space membership.
+func (s *teamSpaceMembershipsService) Get(ctx context.Context, spaceID, membershipID string) (*TeamSpaceMembership, error) {
+	path := fmt.Sprintf("/spaces/%s/team_space_memberships/%s", spaceID, membershipID)
+	req, err := s.c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var membership TeamSpaceMembership
+	if err := s.c.do(req, &membership); err != nil {
+		return nil, err
+	}
+
+	return &membership, nil
+}
+
+// Upsert creates the team space membership or updates it if it already
+// exists. The team of a membership can't be changed.
+func (s *teamSpaceMembershipsService) Upsert(ctx context.Context, spaceID string, membership *TeamSpaceMembership) error {
+	method := http.MethodPost
+	path := fmt.Sprintf("/spaces/%s/team_space_memberships", spaceID)
+	if membership.Sys != nil && membership.Sys.CreatedAt != "" {
+		method = http.MethodPut
+		path = fmt.Sprintf("/spaces/%s/team_space_memberships/%s", spaceID, membership.Sys.ID)
+	}
+
+	req, err := s.c.newRequest(ctx, method, path, &membershipBody{
+		Admin: membership.Admin,
+		Roles: membership.Roles,
+	})
+	if err != nil {
+		return err
+	}
+
+	if membership.Team != nil && membership.Team.Sys != nil {
+		req.Header.Set("X-Contentful-Team", membership.Team.Sys.ID)
+	}
+	if method == http.MethodPut {
+		req.Header.Set("X-Contentful-Version", strconv.Itoa(membership.Sys.Version))
+	}
+
+	return s.c.do(req, membership)
+}
+
+// Delete deletes the team space membership.
+func (s *teamSpaceMembershipsService) Delete(ctx context.Context, spaceID string, membership *TeamSpaceMembership) error {
+	path := fmt.Sprintf("/spaces/%s/team_space_memberships/%s", spaceID, membership.Sys.ID)
+	req, err := s.c.newRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.c.do(req, nil)
+}
+
+// GetTeam returns the team of the organization of the provider.
+func (s *teamSpaceMembershipsService) GetTeam(ctx context.Context, teamID string) (*Team, error) {
+	path := fmt.Sprintf("/organizations/%s/teams/%s", s.c.organizationID, teamID)
+	req, err := s.c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var team Team
+	if err := s.c.do(req, &team); err != nil {
+		return nil, err
+	}
+
+	return &team, nil
+}
@@ -0,0 +1,101 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	contentful "github.com/kitagry/contentful-go"
+)
+
+// Webhook is a webhook definition. The model of contentful-go lacks secret
+// headers, filters and transformations.
+type Webhook struct {
+	Sys               *contentful.Sys        `json:"sys,omitempty"`
+	Name              string                 `json:"name,omitempty"`
+	URL               string                 `json:"url,omitempty"`
+	Topics            []string               `json:"topics,omitempty"`
+	HTTPBasicUsername string                 `json:"httpBasicUsername,omitempty"`
+	HTTPBasicPassword string                 `json:"httpBasicPassword,omitempty"`
+	Headers           []WebhookHeader        `json:"headers"`
+	Filters           []WebhookFilter        `json:"filters,omitempty"`
+	Transformation    *WebhookTransformation `json:"transformation,omitempty"`
+}
+
+// WebhookHeader is a header sent with every call of the webhook. The values of
+// secret headers are never returned by the API.
+type WebhookHeader struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Secret bool   `json:"secret,omitempty"`
+}
+
+// WebhookFilter restricts the calls of a webhook. It holds a single operator,
+// "equals", "in", "regexp" or "not", e.g.
+// {"equals": [{"doc": "sys.id"}, "entry-id"]}.
+type WebhookFilter map[string]interface{}
+
+// WebhookTransformation customizes the request sent by a webhook.
+type WebhookTransformation struct {
+	Method               string          `json:"method,omitempty"`
+	ContentType          string          `json:"contentType,omitempty"`
+	IncludeContentLength *bool           `json:"includeContentLength,omitempty"`
+	Body                 json.RawMessage `json:"body,omitempty"`
+}
+
+// webhooksService replaces the WebhooksService of contentful-go.
+type webhooksService struct {
+	c *providerClient
+}
+
+// Get returns the webhook definition.
+func (s *webhooksService) Get(ctx context.Context, spaceID, webhookID string) (*Webhook, error) {
+	path := fmt.Sprintf("/spaces/%s/webhook_definitions/%s", spaceID, webhookID)
+	req, err := s.c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhook Webhook
+	if err := s.c.do(req, &webhook); err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+// Upsert creates the webhook or updates it if it already exists.
+func (s *webhooksService) Upsert(ctx context.Context, spaceID string, webhook *Webhook) error {
+	method := http.MethodPost
+	path := fmt.Sprintf("/spaces/%s/webhook_definitions", spaceID)
+	if webhook.Sys != nil && webhook.Sys.CreatedAt != "" {
+		method = http.MethodPut
+		path = fmt.Sprintf("/spaces/%s/webhook_definitions/%s", spaceID, webhook.Sys.ID)
+	}
+
+	req, err := s.c.newRequest(ctx, method, path, webhook)
+	if err != nil {
+		return err
+	}
+
+	if method == http.MethodPut {
+		req.Header.Set("X-Contentful-Version", strconv.Itoa(webhook.Sys.Version))
+	}
+
+	return s.c.do(req, webhook)
+}
+
+// Delete deletes the webhook.
+func (s *webhooksService) Delete(ctx context.Context, spaceID string, webhook *Webhook) error {
+	path := fmt.Sprintf("/spaces/%s/webhook_definitions/%s", spaceID, webhook.Sys.ID)
+	req, err := s.c.newRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(webhook.Sys.Version))
+
+	return s.c.do(req, nil)
+}
@@ -2,6 +2,11 @@ package contentful
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,19 +15,21 @@ import (
 
 type ContentfulAPIKeyClient interface {
 	Get(context.Context, string, string) (*contentful.APIKey, error)
+	GetPreviewAPIKey(context.Context, string, string) (*PreviewAPIKey, error)
 	Upsert(context.Context, string, *contentful.APIKey) error
 	Delete(context.Context, string, *contentful.APIKey) error
 }
 
 type ContentfulAssetClient interface {
-	Get(ctx context.Context, spaceID string, assetID string) (*contentful.Asset, error)
-	Upsert(ctx context.Context, spaceID string, asset *contentful.Asset) error
-	Process(ctx context.Context, spaceID string, asset *contentful.Asset) error
-	Delete(ctx context.Context, spaceID string, asset *contentful.Asset) error
-	Publish(ctx context.Context, spaceID string, asset *contentful.Asset) error
-	Unpublish(ctx context.Context, spaceID string, asset *contentful.Asset) error
-	Archive(ctx context.Context, spaceID string, asset *contentful.Asset) error
-	Unarchive(ctx context.Context, spaceID string, asset *contentful.Asset) error
+	Get(ctx context.Context, env *contentful.Environment, assetID string) (*contentful.Asset, error)
+	Upsert(ctx context.Context, env *contentful.Environment, asset *contentful.Asset) error
+	Process(ctx context.Context, env *contentful.Environment, asset *contentful.Asset, locale string) error
+	Delete(ctx context.Context, env *contentful.Environment, asset *contentful.Asset) error
+	Publish(ctx context.Context, env *contentful.Environment, asset *contentful.Asset) error
+	Unpublish(ctx context.Context, env *contentful.Environment, asset *contentful.Asset) error
+	Archive(ctx context.Context, env *contentful.Environment, asset *contentful.Asset) error
+	Unarchive(ctx context.Context, env *contentful.Environment, asset *contentful.Asset) error
+	CreateUpload(ctx context.Context, env *contentful.Environment, content io.Reader) (*Upload, error)
 }
 
 type ContentfulContentTypeClient interface {
@@ -33,6 +40,11 @@ type ContentfulContentTypeClient interface {
 	Delete(ctx context.Context, env *contentful.Environment, ct *contentful.ContentType) error
 }
 
+type ContentfulEditorInterfaceClient interface {
+	Get(ctx context.Context, env *contentful.Environment, contentTypeID string) (*EditorInterface, error)
+	Update(ctx context.Context, env *contentful.Environment, contentTypeID string, ei *EditorInterface) error
+}
+
 type ContentfulEntryClient interface {
 	Get(ctx context.Context, env *contentful.Environment, entryID string) (*contentful.Entry, error)
 	Upsert(ctx context.Context, env *contentful.Environment, contentTypeID string, e *contentful.Entry) error
@@ -46,26 +58,74 @@ type ContentfulEntryClient interface {
 
 type ContentfulEnvironmentClient interface {
 	Get(ctx context.Context, spaceID string, environmentID string) (*contentful.Environment, error)
+	Create(ctx context.Context, spaceID string, e *contentful.Environment, sourceEnvironmentID string) error
+	Status(ctx context.Context, spaceID string, environmentID string) (string, error)
 	Upsert(ctx context.Context, spaceID string, e *contentful.Environment) error
 	Delete(ctx context.Context, spaceID string, e *contentful.Environment) error
 }
 
+type ContentfulEnvironmentAliasClient interface {
+	Get(ctx context.Context, spaceID string, environmentAliasID string) (*contentful.EnvironmentAlias, error)
+	Create(ctx context.Context, spaceID string, ea *contentful.EnvironmentAlias) error
+	Update(ctx context.Context, spaceID string, ea *contentful.EnvironmentAlias) error
+	Delete(ctx context.Context, spaceID string, ea *contentful.EnvironmentAlias) error
+}
+
+// ContentfulLocaleClient manages the locales of an environment, or of the
+// space if environmentID is empty.
 type ContentfulLocaleClient interface {
-	Get(context.Context, string, string) (*contentful.Locale, error)
-	Upsert(context.Context, string, *contentful.Locale) error
-	Delete(context.Context, string, *contentful.Locale) error
+	List(ctx context.Context, spaceID, environmentID string) ([]*contentful.Locale, error)
+	Get(ctx context.Context, spaceID, environmentID, localeID string) (*contentful.Locale, error)
+	Upsert(ctx context.Context, spaceID, environmentID string, locale *contentful.Locale) error
+	Delete(ctx context.Context, spaceID, environmentID string, locale *contentful.Locale) error
+}
+
+type ContentfulRoleClient interface {
+	Get(context.Context, string, string) (*Role, error)
+	Upsert(context.Context, string, *Role) error
+	Delete(context.Context, string, *Role) error
 }
 
 type ContentfulSpaceClient interface {
+	List(context.Context) *contentful.Collection
 	Get(context.Context, string) (*contentful.Space, error)
 	Upsert(context.Context, *contentful.Space) error
 	Delete(context.Context, *contentful.Space) error
 }
 
+type ContentfulSpaceMembershipClient interface {
+	Get(context.Context, string, string) (*SpaceMembership, error)
+	Upsert(context.Context, string, *SpaceMembership) error
+	Delete(context.Context, string, *SpaceMembership) error
+	GetUser(context.Context, string) (*OrganizationUser, error)
+}
+
+type ContentfulTeamSpaceMembershipClient interface {
+	Get(context.Context, string, string) (*TeamSpaceMembership, error)
+	Upsert(context.Context, string, *TeamSpaceMembership) error
+	Delete(context.Context, string, *TeamSpaceMembership) error
+	GetTeam(context.Context, string) (*Team, error)
+}
+
 type ContentfulWebhookClient interface {
-	Get(context.Context, string, string) (*contentful.Webhook, error)
-	Upsert(context.Context, string, *contentful.Webhook) error
-	Delete(context.Context, string, *contentful.Webhook) error
+	Get(context.Context, string, string) (*Webhook, error)
+	Upsert(context.Context, string, *Webhook) error
+	Delete(context.Context, string, *Webhook) error
+}
+
+// forEachPage fetches every page of the collection and calls f with it.
+func forEachPage(col *contentful.Collection, f func(col *contentful.Collection)) error {
+	for {
+		if _, err := col.Next(); err != nil {
+			return err
+		}
+
+		f(col)
+
+		if len(col.Items) == 0 || col.Skip+len(col.Items) >= col.Total {
+			return nil
+		}
+	}
 }
 
 func contentfulErrorToDiagnostic(err error) diag.Diagnostics {
@@ -87,6 +147,16 @@ func contentfulErrorToDiagnostic(err error) diag.Diagnostics {
 }
 
 func convertContentfulErrorResponse(v *contentful.ErrorResponse) diag.Diagnostics {
+	// Errors such as AccessDenied come without details.
+	if v.Details == nil || len(v.Details.Errors) == 0 {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  v.Message,
+			},
+		}
+	}
+
 	diags := make(diag.Diagnostics, 0)
 	for _, e := range v.Details.Errors {
 		var path cty.Path
@@ -114,3 +184,39 @@ func convertContentfulErrorResponse(v *contentful.ErrorResponse) diag.Diagnostic
 	}
 	return diags
 }
+
+// defaultEnvironmentID is used by resources whose env_id is optional.
+const defaultEnvironmentID = "master"
+
+// parseImportID splits a composite import ID such as "space_id/entry_id" into
+// its parts. The names of the parts are only used for the error message.
+func parseImportID(id string, parts ...string) ([]string, error) {
+	ids := strings.Split(id, "/")
+	if len(ids) != len(parts) {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected %s", id, strings.Join(parts, "/"))
+	}
+
+	for _, v := range ids {
+		if v == "" {
+			return nil, fmt.Errorf("unexpected format of ID (%q), expected %s", id, strings.Join(parts, "/"))
+		}
+	}
+
+	return ids, nil
+}
+
+// jsonEqual reports whether both strings are valid JSON with the same value.
+func jsonEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	var va, vb interface{}
+	if err := json.Unmarshal([]byte(a), &va); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &vb); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
@@ -68,6 +68,16 @@ func TestContentfulErrorToDiagnostic(t *testing.T) {
 				},
 			},
 		},
+		"ErrorResponse without details should return the message": {
+			err: contentful.ErrorResponse{
+				Message: "msg",
+			},
+			expect: diag.Diagnostics{
+				{
+					Summary: "msg",
+				},
+			},
+		},
 	}
 
 	for n, tt := range tests {
@@ -79,3 +89,51 @@ func TestContentfulErrorToDiagnostic(t *testing.T) {
 		})
 	}
 }
+
+func TestParseImportID(t *testing.T) {
+	tests := map[string]struct {
+		id    string
+		parts []string
+
+		expect    []string
+		expectErr bool
+	}{
+		"two parts": {
+			id:     "space/webhook",
+			parts:  []string{"space_id", "webhook_id"},
+			expect: []string{"space", "webhook"},
+		},
+		"three parts": {
+			id:     "space/master/entry",
+			parts:  []string{"space_id", "env_id", "entry_id"},
+			expect: []string{"space", "master", "entry"},
+		},
+		"too few parts": {
+			id:        "entry",
+			parts:     []string{"space_id", "env_id", "entry_id"},
+			expectErr: true,
+		},
+		"too many parts": {
+			id:        "space/master/entry",
+			parts:     []string{"space_id", "asset_id"},
+			expectErr: true,
+		},
+		"empty part": {
+			id:        "space//entry",
+			parts:     []string{"space_id", "env_id", "entry_id"},
+			expectErr: true,
+		},
+	}
+
+	for n, tt := range tests {
+		t.Run(n, func(t *testing.T) {
+			got, err := parseImportID(tt.id, tt.parts...)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("parseImportID error = %v, expectErr %v", err, tt.expectErr)
+			}
+			if diff := cmp.Diff(tt.expect, got); diff != "" {
+				t.Errorf("parseImportID result diff (-expect, +got)\n%s", diff)
+			}
+		})
+	}
+}
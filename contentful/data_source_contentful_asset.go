@@ -0,0 +1,232 @@
+package contentful
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	contentful "github.com/kitagry/contentful-go"
+)
+
+func dataSourceContentfulAsset() *schema.Resource {
+	localizedContent := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: wrapAsset(dataSourceReadAsset),
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"env_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the environment. Defaults to master",
+			},
+			"asset_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fields": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"title": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     localizedContent,
+						},
+						"description": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     localizedContent,
+						},
+						"file": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"locale": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"url": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"file_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"content_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"details": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"size": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"image": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"width": {
+																Type:     schema.TypeInt,
+																Computed: true,
+															},
+															"height": {
+																Type:     schema.TypeInt,
+																Computed: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"published": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"archived": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the asset: draft, changed, published or archived",
+			},
+		},
+	}
+}
+
+func dataSourceReadAsset(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulAssetClient) (diags diag.Diagnostics) {
+	asset, err := client.Get(ctx, env, d.Get("asset_id").(string))
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	if err := setAssetProperties(d, asset); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	if err := d.Set("fields", flattenAssetFields(asset.Fields)); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	d.SetId(asset.Sys.ID)
+
+	return
+}
+
+// flattenAssetFields converts the localized fields of an asset into the
+// "fields" list of the data source. Every list is sorted by locale.
+func flattenAssetFields(fields *contentful.AssetFields) []interface{} {
+	if fields == nil {
+		return nil
+	}
+
+	locales := make([]string, 0, len(fields.File))
+	for locale := range fields.File {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	var files []interface{}
+	for _, locale := range locales {
+		file := fields.File[locale]
+		files = append(files, map[string]interface{}{
+			"locale":       locale,
+			"url":          file.URL,
+			"file_name":    file.FileName,
+			"content_type": file.ContentType,
+			"details":      flattenFileDetails(file.Details),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"title":       flattenLocalizedContent(fields.Title),
+			"description": flattenLocalizedContent(fields.Description),
+			"file":        files,
+		},
+	}
+}
+
+func flattenLocalizedContent(content map[string]string) []interface{} {
+	var result []interface{}
+	for _, locale := range sortedLocales(content) {
+		result = append(result, map[string]interface{}{
+			"content": content[locale],
+			"locale":  locale,
+		})
+	}
+	return result
+}
+
+func flattenFileDetails(details *contentful.FileDetails) []interface{} {
+	if details == nil {
+		return nil
+	}
+
+	var image []interface{}
+	if details.Image != nil {
+		image = []interface{}{
+			map[string]interface{}{
+				"width":  details.Image.Width,
+				"height": details.Image.Height,
+			},
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"size":  details.Size,
+			"image": image,
+		},
+	}
+}
+
+// sortedLocales returns the locales of a localized value in a stable order.
+func sortedLocales(localized map[string]string) []string {
+	locales := make([]string, 0, len(localized))
+	for locale := range localized {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
@@ -0,0 +1,139 @@
+package contentful
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	contentful "github.com/kitagry/contentful-go"
+)
+
+func dataSourceContentfulContentType() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: wrapContentType(dataSourceContentTypeRead),
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"env_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"content_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_field": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"field": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"link_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"items": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"link_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"validations": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"validation": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     validationSchema().Elem,
+									},
+								},
+							},
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"localized": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"disabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"omitted": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"validations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"validation": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     validationSchema().Elem,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceContentTypeRead(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulContentTypeClient) (diags diag.Diagnostics) {
+	ct, err := client.Get(ctx, env, d.Get("content_type_id").(string))
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	if err := setContentTypeProperties(d, ct); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	d.SetId(ct.Sys.ID)
+
+	return
+}
@@ -0,0 +1,50 @@
+package contentful
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceContentfulEnvironment() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: wrapEnvironment(dataSourceReadEnvironment),
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceReadEnvironment(ctx context.Context, d *schema.ResourceData, client ContentfulEnvironmentClient) (diags diag.Diagnostics) {
+	environment, err := client.Get(ctx, d.Get("space_id").(string), d.Get("environment_id").(string))
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	if err := setEnvironmentProperties(d, environment); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	d.SetId(environment.Sys.ID)
+
+	return
+}
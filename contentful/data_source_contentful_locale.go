@@ -0,0 +1,86 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	contentful "github.com/kitagry/contentful-go"
+)
+
+func dataSourceContentfulLocale() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: wrapLocale(dataSourceReadLocale),
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"env_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the environment. Without it, the locales of the space are read",
+			},
+			"code": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fallback_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"optional": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"cda": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"cma": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceReadLocale(ctx context.Context, d *schema.ResourceData, client ContentfulLocaleClient) (diags diag.Diagnostics) {
+	spaceID := d.Get("space_id").(string)
+	code := d.Get("code").(string)
+
+	locales, err := client.List(ctx, spaceID, d.Get("env_id").(string))
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	var locale *contentful.Locale
+	for _, l := range locales {
+		if l.Code == code {
+			locale = l
+		}
+	}
+	if locale == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("no locale with code %q found in space %q", code, spaceID),
+		})
+		return
+	}
+
+	if err := setLocaleProperties(d, locale); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	d.SetId(locale.Sys.ID)
+
+	return
+}
@@ -0,0 +1,88 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	contentful "github.com/kitagry/contentful-go"
+)
+
+func dataSourceContentfulSpace() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: wrapSpace(dataSourceSpaceRead),
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"space_id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"space_id", "name"},
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSpaceRead(ctx context.Context, d *schema.ResourceData, client ContentfulSpaceClient) (diags diag.Diagnostics) {
+	var space *contentful.Space
+	var err error
+	if spaceID, ok := d.GetOk("space_id"); ok {
+		space, err = client.Get(ctx, spaceID.(string))
+	} else {
+		space, err = findSpaceByName(ctx, client, d.Get("name").(string))
+	}
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	if err := d.Set("space_id", space.Sys.ID); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	if err := updateSpaceProperties(d, space); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	d.SetId(space.Sys.ID)
+
+	return
+}
+
+// findSpaceByName returns the only space with the given name. Space names are
+// not unique, so it fails when more than one space matches.
+func findSpaceByName(ctx context.Context, client ContentfulSpaceClient, name string) (*contentful.Space, error) {
+	var spaces []*contentful.Space
+	err := forEachPage(client.List(ctx), func(col *contentful.Collection) {
+		for _, space := range col.ToSpace() {
+			if space.Name == name {
+				spaces = append(spaces, space)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(spaces) {
+	case 0:
+		return nil, fmt.Errorf("no space with name %q found", name)
+	case 1:
+		return spaces[0], nil
+	default:
+		return nil, fmt.Errorf("%d spaces with name %q found, use space_id instead", len(spaces), name)
+	}
+}
@@ -0,0 +1,250 @@
+package contentful
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	contentful "github.com/kitagry/contentful-go"
+)
+
+func readDataSource(t *testing.T, r *schema.Resource, raw map[string]interface{}, meta interface{}) (*schema.ResourceData, diag.Diagnostics) {
+	t.Helper()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	diags := r.ReadContext(context.Background(), d, meta)
+	return d, diags
+}
+
+func TestDataSourceSpace_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space1")
+	server.addSpace("space2")
+	server.addSpace("dup1")
+	server.addSpace("dup2")
+	for _, id := range []string{"dup1", "dup2"} {
+		server.update("spaces/"+id, func(obj map[string]interface{}) {
+			obj["name"] = "duplicated"
+		})
+	}
+
+	tests := map[string]struct {
+		raw       map[string]interface{}
+		expectID  string
+		expectErr bool
+	}{
+		"by id": {
+			raw:      map[string]interface{}{"space_id": "space2"},
+			expectID: "space2",
+		},
+		"by name": {
+			raw:      map[string]interface{}{"name": "space1"},
+			expectID: "space1",
+		},
+		"unknown name": {
+			raw:       map[string]interface{}{"name": "unknown"},
+			expectErr: true,
+		},
+		"ambiguous name": {
+			raw:       map[string]interface{}{"name": "duplicated"},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			d, diags := readDataSource(t, dataSourceContentfulSpace(), tt.raw, meta)
+			if tt.expectErr != diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if tt.expectErr {
+				return
+			}
+
+			if d.Id() != tt.expectID {
+				t.Errorf("expected ID %q, got %q", tt.expectID, d.Id())
+			}
+			if got := d.Get("name"); got != tt.expectID {
+				t.Errorf("expected name %q, got %q", tt.expectID, got)
+			}
+		})
+	}
+}
+
+func TestDataSourceEnvironment_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+
+	d, diags := readDataSource(t, dataSourceContentfulEnvironment(), map[string]interface{}{
+		"space_id":       "space",
+		"environment_id": "master",
+	}, meta)
+	if diags.HasError() {
+		t.Fatalf("failed to read environment: %v", diags)
+	}
+
+	if d.Id() != "master" || d.Get("name") != "master" {
+		t.Errorf("unexpected environment: id=%q name=%q", d.Id(), d.Get("name"))
+	}
+}
+
+func TestDataSourceLocale_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+
+	d, diags := readDataSource(t, dataSourceContentfulLocale(), map[string]interface{}{
+		"space_id": "space",
+		"code":     "en-US",
+	}, meta)
+	if diags.HasError() {
+		t.Fatalf("failed to read locale: %v", diags)
+	}
+	if d.Id() == "" || d.Get("code") != "en-US" {
+		t.Errorf("unexpected locale: id=%q code=%q", d.Id(), d.Get("code"))
+	}
+
+	_, diags = readDataSource(t, dataSourceContentfulLocale(), map[string]interface{}{
+		"space_id": "space",
+		"code":     "fr-FR",
+	}, meta)
+	if !diags.HasError() {
+		t.Error("reading an unknown locale should fail")
+	}
+}
+
+func TestDataSourceContentTypeAndEntry_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+	ctx := context.Background()
+
+	ct := resourceContentfulContentType()
+	ctData := schema.TestResourceDataRaw(t, ct.Schema, map[string]interface{}{
+		"space_id":        "space",
+		"env_id":          "master",
+		"content_type_id": "post",
+		"name":            "Post",
+		"display_field":   "title",
+		"field": []interface{}{
+			map[string]interface{}{"id": "title", "name": "Title", "type": "Symbol", "required": true},
+		},
+	})
+	if diags := ct.CreateContext(ctx, ctData, meta); diags.HasError() {
+		t.Fatalf("failed to create content type: %v", diags)
+	}
+
+	entry := resourceContentfulEntry()
+	entryData := schema.TestResourceDataRaw(t, entry.Schema, map[string]interface{}{
+		"entry_id":       "post1",
+		"space_id":       "space",
+		"env_id":         "master",
+		"contenttype_id": "post",
+		"locale":         "en-US",
+		"field": []interface{}{
+			map[string]interface{}{"id": "title", "locale": "en-US", "content": "Hello"},
+		},
+		"published": true,
+		"archived":  false,
+	})
+	if diags := entry.CreateContext(ctx, entryData, meta); diags.HasError() {
+		t.Fatalf("failed to create entry: %v", diags)
+	}
+
+	d, diags := readDataSource(t, dataSourceContentfulContentType(), map[string]interface{}{
+		"space_id":        "space",
+		"env_id":          "master",
+		"content_type_id": "post",
+	}, meta)
+	if diags.HasError() {
+		t.Fatalf("failed to read content type: %v", diags)
+	}
+	if d.Get("name") != "Post" || d.Get("field.0.id") != "title" || d.Get("field.0.required") != true {
+		t.Errorf("unexpected content type: %v", d.State().Attributes)
+	}
+
+	d, diags = readDataSource(t, dataSourceContentfulEntry(), map[string]interface{}{
+		"space_id": "space",
+		"env_id":   "master",
+		"entry_id": "post1",
+	}, meta)
+	if diags.HasError() {
+		t.Fatalf("failed to read entry: %v", diags)
+	}
+	expect := []interface{}{
+		map[string]interface{}{"id": "title", "locale": "en-US", "content": "Hello", "content_json": "", "link": []interface{}{}, "links": []interface{}{}},
+	}
+	if diff := cmp.Diff(expect, d.Get("field")); diff != "" {
+		t.Errorf("field diff (-expect, +got)\n%s", diff)
+	}
+	if d.Get("contenttype_id") != "post" || d.Get("published") != true {
+		t.Errorf("unexpected entry: %v", d.State().Attributes)
+	}
+
+	_, diags = readDataSource(t, dataSourceContentfulEntry(), map[string]interface{}{
+		"space_id": "space",
+		"env_id":   "master",
+		"entry_id": "unknown",
+	}, meta)
+	if !diags.HasError() {
+		t.Error("reading an unknown entry should fail")
+	}
+}
+
+func TestDataSourceAsset_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+	ctx := context.Background()
+
+	client := meta.(*providerClient)
+	asset := &contentful.Asset{
+		Sys:    &contentful.Sys{ID: "logo"},
+		Locale: "en-US",
+		Fields: &contentful.AssetFields{
+			Title: map[string]string{"en-US": "Logo", "de": "Logo DE"},
+			File: map[string]*contentful.File{
+				"en-US": {
+					FileName:    "logo.png",
+					ContentType: "image/png",
+					UploadURL:   "https://example.com/logo.png",
+				},
+			},
+		},
+	}
+	env, err := client.Environments.Get(ctx, "space", "master")
+	if err != nil {
+		t.Fatalf("failed to get environment: %v", err)
+	}
+	if err := client.assets.Upsert(ctx, env, asset); err != nil {
+		t.Fatalf("failed to create asset: %v", err)
+	}
+	if err := client.assets.Process(ctx, env, asset, "en-US"); err != nil {
+		t.Fatalf("failed to process asset: %v", err)
+	}
+	// Processing finishes after the asset was polled once.
+	if _, err := client.assets.Get(ctx, env, "logo"); err != nil {
+		t.Fatalf("failed to get asset: %v", err)
+	}
+
+	d, diags := readDataSource(t, dataSourceContentfulAsset(), map[string]interface{}{
+		"space_id": "space",
+		"asset_id": "logo",
+	}, meta)
+	if diags.HasError() {
+		t.Fatalf("failed to read asset: %v", diags)
+	}
+
+	expectTitle := []interface{}{
+		map[string]interface{}{"content": "Logo DE", "locale": "de"},
+		map[string]interface{}{"content": "Logo", "locale": "en-US"},
+	}
+	if diff := cmp.Diff(expectTitle, d.Get("fields.0.title")); diff != "" {
+		t.Errorf("title diff (-expect, +got)\n%s", diff)
+	}
+	if d.Get("fields.0.file.0.locale") != "en-US" || d.Get("fields.0.file.0.url") == "" {
+		t.Errorf("processed file should have a url: %v", d.State().Attributes)
+	}
+	if d.Get("published") != false {
+		t.Errorf("asset should not be published")
+	}
+}
@@ -0,0 +1,230 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// fakeResourceStep is one step of the lifecycle of a resource, which is run
+// by testFakeResource against the fake server.
+//
+// A step with config plans and applies it, like terraform apply: the resource
+// is created the first time and updated afterwards. A step with importID
+// imports the resource, a step with destroy deletes it, and any other step
+// refreshes the state.
+type fakeResourceStep struct {
+	// name describes the step in failures.
+	name string
+
+	// remote changes the fake server before the step, like an edit in the web
+	// app. It gets the ID of the resource.
+	remote func(id string)
+
+	config   map[string]interface{}
+	importID string
+	destroy  bool
+
+	// planOnly only plans config, and expects changes if expectChanges is
+	// set and an empty plan otherwise.
+	planOnly      bool
+	expectChanges bool
+
+	// expectError is matched against the diagnostics of a failing step. Like
+	// in Terraform, the state returned by a failing apply is kept.
+	expectError *regexp.Regexp
+
+	// check is called with the state after the step.
+	check func(t *testing.T, d *schema.ResourceData)
+}
+
+// testFakeResource runs the steps one after another with the state of the
+// previous step, and returns the final state.
+func testFakeResource(t *testing.T, r *schema.Resource, meta interface{}, steps []fakeResourceStep) *schema.ResourceData {
+	t.Helper()
+
+	ctx := context.Background()
+	var state *terraform.InstanceState
+	for _, step := range steps {
+		if step.remote != nil {
+			id := ""
+			if state != nil {
+				id = state.ID
+			}
+			step.remote(id)
+		}
+
+		var (
+			next *terraform.InstanceState
+			err  error
+		)
+		switch {
+		case step.planOnly:
+			diff, err := planFakeResource(ctx, r, state, step.config, meta)
+			if err != nil {
+				t.Fatalf("%s: failed to plan: %v", step.name, err)
+			}
+			if step.expectChanges != (diff != nil) {
+				t.Errorf("%s: expected changes %t, got %v", step.name, step.expectChanges, diff)
+			}
+			next = state
+		case step.config != nil:
+			next, err = applyFakeResource(ctx, r, state, step.config, meta)
+		case step.importID != "":
+			d := r.Data(nil)
+			d.SetId(step.importID)
+			var imported []*schema.ResourceData
+			imported, err = r.Importer.StateContext(ctx, d, meta)
+			if err == nil {
+				next = imported[0].State()
+			}
+		case step.destroy:
+			var diags diag.Diagnostics
+			next, diags = r.Apply(ctx, state, &terraform.InstanceDiff{Destroy: true}, meta)
+			err = fakeDiagnosticsError(diags)
+		default:
+			var diags diag.Diagnostics
+			next, diags = r.RefreshWithoutUpgrade(ctx, state, meta)
+			err = fakeDiagnosticsError(diags)
+		}
+
+		if step.expectError != nil {
+			if err == nil || !step.expectError.MatchString(err.Error()) {
+				t.Fatalf("%s: expected error matching %q, got %v", step.name, step.expectError, err)
+			}
+		} else if err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if err == nil || next != nil {
+			state = next
+		}
+
+		if step.check != nil {
+			step.check(t, r.Data(state))
+		}
+	}
+
+	return r.Data(state)
+}
+
+// planFakeResource plans the config for the state. Like Terraform, the raw
+// configuration is passed along with the prior state and the plan.
+func planFakeResource(ctx context.Context, r *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}, meta interface{}) (*terraform.InstanceDiff, error) {
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	rawConfig, err := ctyjson.Unmarshal(b, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		return nil, err
+	}
+
+	if state != nil {
+		state = state.DeepCopy()
+		state.RawConfig = rawConfig
+	}
+	diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil || diff == nil {
+		return diff, err
+	}
+	diff.RawConfig = rawConfig
+	return diff, nil
+}
+
+func applyFakeResource(ctx context.Context, r *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}, meta interface{}) (*terraform.InstanceState, error) {
+	if diags := r.Validate(terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		return state, fakeDiagnosticsError(diags)
+	}
+
+	diff, err := planFakeResource(ctx, r, state, raw, meta)
+	if err != nil || diff == nil {
+		return state, err
+	}
+
+	state, diags := r.Apply(ctx, state, diff, meta)
+	return state, fakeDiagnosticsError(diags)
+}
+
+// fakeDiagnosticsError joins the summaries and details of the errors of diags.
+func fakeDiagnosticsError(diags diag.Diagnostics) error {
+	var messages []string
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			messages = append(messages, strings.TrimSpace(d.Summary+" "+d.Detail))
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(messages, "; "))
+}
+
+func createFakeEnvironment(t *testing.T, meta interface{}, spaceID, name string) {
+	t.Helper()
+
+	r := resourceContentfulEnvironment()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"space_id": spaceID,
+		"name":     name,
+	})
+	if diags := r.CreateContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("failed to create environment: %v", diags)
+	}
+}
+
+func createFakeContentType(t *testing.T, meta interface{}, spaceID, envID, contentTypeID string, fields ...string) {
+	t.Helper()
+
+	rawFields := make([]interface{}, 0, len(fields))
+	for _, id := range fields {
+		rawFields = append(rawFields, map[string]interface{}{"id": id, "name": id, "type": "Symbol"})
+	}
+
+	r := resourceContentfulContentType()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"space_id":        spaceID,
+		"env_id":          envID,
+		"content_type_id": contentTypeID,
+		"name":            contentTypeID,
+		"display_field":   fields[0],
+		"field":           rawFields,
+	})
+	if diags := r.CreateContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("failed to create content type: %v", diags)
+	}
+}
+
+func createFakeRole(t *testing.T, meta interface{}, spaceID, name string) string {
+	t.Helper()
+
+	r := resourceContentfulRole()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"space_id": spaceID,
+		"name":     name,
+	})
+	if diags := r.CreateContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("failed to create role: %v", diags)
+	}
+
+	return d.Id()
+}
+
+// checkFakeAttributes compares the attributes of the state with expect.
+func checkFakeAttributes(t *testing.T, d *schema.ResourceData, expect map[string]interface{}) {
+	t.Helper()
+
+	for k, v := range expect {
+		if diff := cmp.Diff(v, d.Get(k)); diff != "" {
+			t.Errorf("%s diff (-expect, +got)\n%s", k, diff)
+		}
+	}
+}
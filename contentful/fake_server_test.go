@@ -0,0 +1,922 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fakeSysTypes maps the collections of the Contentful Management API to the
+// sys.type of their items.
+var fakeSysTypes = map[string]string{
+	"spaces":                 "Space",
+	"environments":           "Environment",
+	"environment_aliases":    "EnvironmentAlias",
+	"content_types":          "ContentType",
+	"entries":                "Entry",
+	"assets":                 "Asset",
+	"locales":                "Locale",
+	"webhook_definitions":    "WebhookDefinition",
+	"api_keys":               "ApiKey",
+	"preview_api_keys":       "PreviewApiKey",
+	"roles":                  "Role",
+	"space_memberships":      "SpaceMembership",
+	"team_space_memberships": "TeamSpaceMembership",
+	"users":                  "User",
+	"teams":                  "Team",
+}
+
+const fakeOrganizationPath = "organizations/fake-organization"
+
+// fakeReadOnlyFields are set by the server and kept when an object is updated.
+var fakeReadOnlyFields = map[string]bool{
+	"accessToken":     true,
+	"preview_api_key": true,
+	"user":            true,
+	"team":            true,
+}
+
+// fakeServer is an in-memory stand-in for the endpoints of the Contentful
+// Management API used by the provider. Objects are versioned through the
+// X-Contentful-Version header and entries, assets and content types can be
+// published and archived. Errors are returned with the same bodies as the
+// real API so that they go through the contentful-go error handling.
+type fakeServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	objects  map[string]map[string]interface{}
+	order    []string
+	nextID   int
+	requests []string
+
+	// processing holds the locales of the asset files which are processed
+	// when the asset is polled the next time.
+	processing map[string][]string
+
+	// uploads holds the content of the files sent to the Upload API.
+	uploads map[string][]byte
+
+	// failures holds the status codes returned for "METHOD path" instead of
+	// handling the requests.
+	failures map[string]int
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	t.Helper()
+
+	s := &fakeServer{
+		objects:    map[string]map[string]interface{}{},
+		processing: map[string][]string{},
+		uploads:    map[string][]byte{},
+		failures:   map[string]int{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// newFakeClient starts a fake server and returns it together with a provider
+// meta which was configured by providerConfigure to talk to it.
+func newFakeClient(t *testing.T) (*fakeServer, interface{}) {
+	t.Helper()
+
+	s := newFakeServer(t)
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"cma_token":       "fake-token",
+		"organization_id": "fake-organization",
+		"base_url":        s.URL,
+		"upload_url":      s.URL,
+	})
+
+	meta, diags := providerConfigure(context.Background(), d)
+	if diags.HasError() {
+		t.Fatalf("failed to configure provider: %v", diags)
+	}
+
+	return s, meta
+}
+
+// providers returns providers for resource.UnitTest which talk to the fake
+// server. The provider is configured through the environment, so test
+// configurations don't need a provider block. The test is skipped without a
+// Terraform CLI, since resource.UnitTest would try to download one.
+func (s *fakeServer) providers(t *testing.T) map[string]*schema.Provider {
+	t.Helper()
+
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") == "" && os.Getenv("TF_ACC_TERRAFORM_VERSION") == "" {
+		if _, err := exec.LookPath("terraform"); err != nil {
+			t.Skip("terraform is not installed, set TF_ACC_TERRAFORM_PATH to run this test")
+		}
+	}
+
+	t.Setenv("CONTENTFUL_MANAGEMENT_TOKEN", "fake-token")
+	t.Setenv("CONTENTFUL_ORGANIZATION_ID", "fake-organization")
+	t.Setenv("CONTENTFUL_BASE_URL", s.URL)
+	t.Setenv("CONTENTFUL_UPLOAD_URL", s.URL)
+
+	return map[string]*schema.Provider{
+		"contentful": Provider(),
+	}
+}
+
+// addSpace creates a space together with its master environment, the master
+// alias and its default locale, like Contentful does for new spaces.
+func (s *fakeServer) addSpace(spaceID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.createSpace(spaceID, map[string]interface{}{"name": spaceID})
+}
+
+// addUser adds a user to the organization of the fake provider.
+func (s *fakeServer) addUser(userID, email string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.store(fakeOrganizationPath+"/users/"+userID, map[string]interface{}{
+		"email":     email,
+		"firstName": userID,
+		"lastName":  "Fake",
+		"sys":       map[string]interface{}{"id": userID, "type": "User", "version": 1},
+	})
+}
+
+// addTeam adds a team to the organization of the fake provider.
+func (s *fakeServer) addTeam(teamID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.store(fakeOrganizationPath+"/teams/"+teamID, map[string]interface{}{
+		"name": teamID,
+		"sys":  map[string]interface{}{"id": teamID, "type": "Team", "version": 1},
+	})
+}
+
+// object returns a copy of the stored object, or nil if it doesn't exist.
+func (s *fakeServer) object(path string) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.objects[path]
+	if !ok {
+		return nil
+	}
+	return copyObject(obj)
+}
+
+// update modifies a stored object in place as if it was changed in the web app.
+func (s *fakeServer) update(path string, f func(obj map[string]interface{})) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.objects[path]
+	if !ok {
+		panic("fake object not found: " + path)
+	}
+	f(obj)
+	bumpVersion(obj)
+}
+
+// remove deletes a stored object as if it was deleted in the web app.
+func (s *fakeServer) remove(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.objects, path)
+}
+
+// fail makes every following request with the method to the path fail with
+// the status, e.g. to simulate missing permissions.
+func (s *fakeServer) fail(method, path string, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures[method+" "+path] = status
+}
+
+// requestLog returns the "METHOD path" of every request received so far.
+func (s *fakeServer) requestLog() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.requests...)
+}
+
+func (s *fakeServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+
+	if status, ok := s.failures[r.Method+" "+strings.Trim(r.URL.Path, "/")]; ok {
+		writeFakeError(w, status, strings.ReplaceAll(http.StatusText(status), " ", ""), http.StatusText(status))
+		return
+	}
+
+	// Uploads have a binary body.
+	if r.Method == http.MethodPost && strings.HasSuffix(strings.TrimRight(r.URL.Path, "/"), "/uploads") {
+		s.handleUpload(w, r, strings.Trim(r.URL.Path, "/"))
+		return
+	}
+
+	var body map[string]interface{}
+	if r.Body != nil {
+		defer r.Body.Close()
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+			writeFakeError(w, http.StatusBadRequest, "BadRequest", "invalid JSON body: "+err.Error())
+			return
+		}
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	n := len(segments)
+	switch {
+	case n >= 3 && (segments[n-1] == "published" || segments[n-1] == "archived"):
+		s.handleState(w, r, strings.Join(segments[:n-1], "/"), segments[n-1])
+	case n >= 7 && segments[n-1] == "editor_interface":
+		s.handleObject(w, r, strings.Join(segments, "/"), body)
+	case n >= 5 && segments[n-1] == "process" && segments[n-3] == "files":
+		s.handleProcess(w, r, strings.Join(segments[:n-3], "/"), segments[n-2])
+	case n%2 == 1:
+		s.handleCollection(w, r, strings.Join(segments, "/"), body)
+	default:
+		s.handleObject(w, r, strings.Join(segments, "/"), body)
+	}
+}
+
+func (s *fakeServer) handleCollection(w http.ResponseWriter, r *http.Request, path string, body map[string]interface{}) {
+	switch r.Method {
+	case http.MethodGet:
+		items := make([]interface{}, 0)
+		for _, key := range s.order {
+			obj, ok := s.objects[key]
+			if !ok || !strings.HasPrefix(key, path+"/") || strings.Contains(strings.TrimPrefix(key, path+"/"), "/") {
+				continue
+			}
+			items = append(items, obj)
+		}
+
+		total := len(items)
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		if limit <= 0 {
+			limit = 100
+		}
+		if skip > len(items) {
+			skip = len(items)
+		}
+		items = items[skip:]
+		if len(items) > limit {
+			items = items[:limit]
+		}
+
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{
+			"sys":   map[string]interface{}{"type": "Array"},
+			"total": total,
+			"skip":  skip,
+			"limit": limit,
+			"items": items,
+		})
+	case http.MethodPost:
+		s.nextID++
+		id := fmt.Sprintf("fake%d", s.nextID)
+		if path == "spaces" {
+			writeFakeJSON(w, http.StatusCreated, s.createSpace(id, body))
+			return
+		}
+		s.create(w, r, path+"/"+id, body)
+	default:
+		writeFakeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method+" is not allowed on "+path)
+	}
+}
+
+func (s *fakeServer) handleObject(w http.ResponseWriter, r *http.Request, path string, body map[string]interface{}) {
+	obj, exists := s.objects[path]
+
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			writeFakeNotFound(w)
+			return
+		}
+		writeFakeJSON(w, http.StatusOK, obj)
+
+		// Clones become ready and files are processed after they were polled
+		// once.
+		switch sys := obj["sys"].(map[string]interface{}); sys["type"] {
+		case "Environment":
+			sys["status"] = fakeLink("Status", "ready")
+		case "Asset":
+			s.finishProcessing(path, obj)
+		}
+	case http.MethodPut:
+		if !exists {
+			s.create(w, r, path, body)
+			return
+		}
+		if !checkFakeVersion(w, r, obj) {
+			return
+		}
+		for k := range obj {
+			if k != "sys" && !fakeReadOnlyFields[k] {
+				delete(obj, k)
+			}
+		}
+		for k, v := range body {
+			if k != "sys" && !fakeReadOnlyFields[k] {
+				obj[k] = v
+			}
+		}
+		bumpVersion(obj)
+		writeFakeJSON(w, http.StatusOK, obj)
+	case http.MethodDelete:
+		if !exists {
+			writeFakeNotFound(w)
+			return
+		}
+		if !checkFakeVersion(w, r, obj) {
+			return
+		}
+		sys := obj["sys"].(map[string]interface{})
+		switch sys["type"] {
+		case "Entry", "Asset":
+			if sys["publishedAt"] != nil || sys["archivedAt"] != nil {
+				writeFakeValidationError(w, "state", "Cannot delete published or archived "+strings.ToLower(sys["type"].(string)))
+				return
+			}
+		case "ContentType":
+			if sys["publishedVersion"] != nil {
+				writeFakeValidationError(w, "state", "Cannot delete an active content type")
+				return
+			}
+		}
+		for key := range s.objects {
+			if key == path || strings.HasPrefix(key, path+"/") {
+				delete(s.objects, key)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeFakeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method+" is not allowed on "+path)
+	}
+}
+
+// handleState publishes, unpublishes, archives and unarchives objects.
+// Content types use the "published" endpoint for activation.
+func (s *fakeServer) handleState(w http.ResponseWriter, r *http.Request, path, state string) {
+	obj, ok := s.objects[path]
+	if !ok {
+		writeFakeNotFound(w)
+		return
+	}
+	if !checkFakeVersion(w, r, obj) {
+		return
+	}
+
+	sys := obj["sys"].(map[string]interface{})
+	now := fakeNow()
+
+	switch {
+	case state == "published" && r.Method == http.MethodPut:
+		if sys["archivedAt"] != nil {
+			writeFakeValidationError(w, "state", "Cannot publish an archived object")
+			return
+		}
+		if sys["type"] == "Asset" && !fakeAssetProcessed(obj) {
+			writeFakeValidationError(w, "fields.file", "Cannot publish an asset with unprocessed files")
+			return
+		}
+		if sys["firstPublishedAt"] == nil {
+			sys["firstPublishedAt"] = now
+		}
+		counter, _ := sys["publishedCounter"].(int)
+		sys["publishedCounter"] = counter + 1
+		sys["publishedAt"] = now
+		sys["publishedVersion"] = sys["version"]
+		if sys["type"] == "ContentType" {
+			s.createEditorInterface(path, obj)
+		}
+	case state == "published" && r.Method == http.MethodDelete:
+		if sys["publishedAt"] == nil && sys["publishedVersion"] == nil {
+			writeFakeValidationError(w, "state", "Cannot unpublish an object which is not published")
+			return
+		}
+		delete(sys, "publishedAt")
+		delete(sys, "publishedVersion")
+	case state == "archived" && r.Method == http.MethodPut:
+		if sys["publishedAt"] != nil {
+			writeFakeValidationError(w, "state", "Cannot archive a published object")
+			return
+		}
+		sys["archivedAt"] = now
+		sys["archivedVersion"] = sys["version"]
+	case state == "archived" && r.Method == http.MethodDelete:
+		if sys["archivedAt"] == nil {
+			writeFakeValidationError(w, "state", "Cannot unarchive an object which is not archived")
+			return
+		}
+		delete(sys, "archivedAt")
+		delete(sys, "archivedVersion")
+	default:
+		writeFakeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method+" is not allowed on "+state)
+		return
+	}
+
+	bumpVersion(obj)
+	writeFakeJSON(w, http.StatusOK, obj)
+}
+
+// handleUpload stores the body of a request to the Upload API.
+func (s *fakeServer) handleUpload(w http.ResponseWriter, r *http.Request, path string) {
+	spacePath := strings.TrimSuffix(path, "/uploads")
+	if _, ok := s.objects[spacePath]; !ok {
+		writeFakeNotFound(w)
+		return
+	}
+	if contentType := r.Header.Get("Content-Type"); contentType != "application/octet-stream" {
+		writeFakeError(w, http.StatusBadRequest, "BadRequest", "unexpected Content-Type "+contentType)
+		return
+	}
+
+	content, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeFakeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	s.nextID++
+	id := fmt.Sprintf("fake%d", s.nextID)
+	obj := map[string]interface{}{
+		"sys": map[string]interface{}{
+			"id":        id,
+			"type":      "Upload",
+			"createdAt": fakeNow(),
+			"space":     fakeLink("Space", strings.TrimPrefix(spacePath, "spaces/")),
+		},
+	}
+	s.store(path+"/"+id, obj)
+	s.uploads[path+"/"+id] = content
+
+	writeFakeJSON(w, http.StatusCreated, obj)
+}
+
+// handleProcess starts processing the file of an asset for the given locale.
+// Like in Contentful, processing is asynchronous: the file gets its url when
+// the asset is read the next time. Starting to process a file changes the
+// version of the asset.
+func (s *fakeServer) handleProcess(w http.ResponseWriter, r *http.Request, path, locale string) {
+	obj, ok := s.objects[path]
+	if !ok {
+		writeFakeNotFound(w)
+		return
+	}
+	if !checkFakeVersion(w, r, obj) {
+		return
+	}
+
+	fields, _ := obj["fields"].(map[string]interface{})
+	files, _ := fields["file"].(map[string]interface{})
+	file, ok := files[locale].(map[string]interface{})
+	if !ok || (file["upload"] == nil && file["uploadFrom"] == nil) {
+		writeFakeValidationError(w, "file", "No file to process for locale "+locale)
+		return
+	}
+	if upload := fakeLinkID(file["uploadFrom"]); upload != "" {
+		if _, ok := s.uploads[fakeUploadPath(path, upload)]; !ok {
+			writeFakeValidationError(w, "file", "Upload "+upload+" doesn't exist")
+			return
+		}
+	}
+
+	s.processing[path] = append(s.processing[path], locale)
+	bumpVersion(obj)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *fakeServer) finishProcessing(path string, obj map[string]interface{}) {
+	locales := s.processing[path]
+	if len(locales) == 0 {
+		return
+	}
+	delete(s.processing, path)
+
+	sys := obj["sys"].(map[string]interface{})
+	space := sys["space"].(map[string]interface{})["sys"].(map[string]interface{})["id"]
+	files := obj["fields"].(map[string]interface{})["file"].(map[string]interface{})
+	for _, locale := range locales {
+		file, ok := files[locale].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		file["url"] = fmt.Sprintf("//images.ctfassets.net/%s/%s/%d/%s", space, sys["id"], sys["version"], file["fileName"])
+		details := map[string]interface{}{"size": 1024}
+		if upload := fakeLinkID(file["uploadFrom"]); upload != "" {
+			details["size"] = len(s.uploads[fakeUploadPath(path, upload)])
+		}
+		if contentType, _ := file["contentType"].(string); strings.HasPrefix(contentType, "image/") {
+			details["image"] = map[string]interface{}{"width": 640, "height": 480}
+		}
+		file["details"] = details
+		delete(file, "upload")
+		delete(file, "uploadFrom")
+	}
+
+	bumpVersion(obj)
+}
+
+func fakeAssetProcessed(obj map[string]interface{}) bool {
+	fields, _ := obj["fields"].(map[string]interface{})
+	files, _ := fields["file"].(map[string]interface{})
+	for _, rawFile := range files {
+		file, _ := rawFile.(map[string]interface{})
+		if file["url"] == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// fakeUploadPath returns the path of an upload of the space of an asset.
+func fakeUploadPath(assetPath, uploadID string) string {
+	segments := strings.SplitN(assetPath, "/", 3)
+	return segments[0] + "/" + segments[1] + "/uploads/" + uploadID
+}
+
+func (s *fakeServer) create(w http.ResponseWriter, r *http.Request, path string, body map[string]interface{}) {
+	segments := strings.Split(path, "/")
+	n := len(segments)
+	collection, id := segments[n-2], segments[n-1]
+
+	parent := strings.Join(segments[:n-2], "/")
+	if _, ok := s.objects[parent]; parent != "" && !ok {
+		writeFakeNotFound(w)
+		return
+	}
+
+	sysType, ok := fakeSysTypes[collection]
+	if !ok {
+		writeFakeNotFound(w)
+		return
+	}
+
+	obj := map[string]interface{}{}
+	for k, v := range body {
+		if k != "sys" {
+			obj[k] = v
+		}
+	}
+
+	sys := map[string]interface{}{
+		"id":        id,
+		"type":      sysType,
+		"version":   1,
+		"createdAt": fakeNow(),
+		"updatedAt": fakeNow(),
+	}
+	if n > 2 {
+		sys["space"] = fakeLink("Space", segments[1])
+	}
+	if n > 4 && segments[2] == "environments" {
+		sys["environment"] = fakeLink("Environment", segments[3])
+	}
+
+	switch sysType {
+	case "Environment":
+		status := "ready"
+		if source := r.Header.Get("X-Contentful-Source-Environment"); source != "" {
+			if _, ok := s.objects[parent+"/environments/"+source]; !ok {
+				writeFakeNotFound(w)
+				return
+			}
+			s.cloneEnvironment(parent, source, id)
+			status = "queued"
+		}
+		sys["status"] = fakeLink("Status", status)
+	case "Entry":
+		contentTypeID := r.Header.Get("X-Contentful-Content-Type")
+		if _, ok := s.objects[parent+"/content_types/"+contentTypeID]; contentTypeID == "" || !ok {
+			writeFakeValidationError(w, "sys.contentType", fmt.Sprintf("Unknown content type %q", contentTypeID))
+			return
+		}
+		sys["contentType"] = fakeLink("ContentType", contentTypeID)
+	case "EnvironmentAlias":
+		environmentID := fakeLinkID(obj["environment"])
+		if _, ok := s.objects[parent+"/environments/"+environmentID]; !ok {
+			writeFakeValidationError(w, "environment", fmt.Sprintf("Unknown environment %q", environmentID))
+			return
+		}
+	case "Locale":
+		for key, other := range s.objects {
+			if strings.HasPrefix(key, parent+"/locales/") && other["code"] == obj["code"] {
+				writeFakeValidationError(w, "code", fmt.Sprintf("Locale code %q is already used", obj["code"]))
+				return
+			}
+		}
+	case "SpaceMembership", "TeamSpaceMembership":
+		roles, _ := obj["roles"].([]interface{})
+		for _, role := range roles {
+			if _, ok := s.objects[parent+"/roles/"+fakeLinkID(role)]; !ok {
+				writeFakeValidationError(w, "roles", fmt.Sprintf("Unknown role %q", fakeLinkID(role)))
+				return
+			}
+		}
+		if sysType == "TeamSpaceMembership" {
+			teamID := r.Header.Get("X-Contentful-Team")
+			if _, ok := s.objects[fakeOrganizationPath+"/teams/"+teamID]; !ok {
+				writeFakeValidationError(w, "team", fmt.Sprintf("Unknown team %q", teamID))
+				return
+			}
+			obj["team"] = fakeLink("Team", teamID)
+			break
+		}
+		// Users are invited by email, unknown ones get a new ID.
+		userID := ""
+		for key, user := range s.objects {
+			if strings.HasPrefix(key, fakeOrganizationPath+"/users/") && user["email"] == obj["email"] {
+				userID = user["sys"].(map[string]interface{})["id"].(string)
+			}
+		}
+		if userID == "" {
+			s.nextID++
+			userID = fmt.Sprintf("fake%d", s.nextID)
+		}
+		delete(obj, "email")
+		obj["user"] = fakeLink("User", userID)
+	case "ApiKey":
+		s.nextID++
+		previewID := fmt.Sprintf("fake%d", s.nextID)
+		s.store(parent+"/preview_api_keys/"+previewID, map[string]interface{}{
+			"sys": map[string]interface{}{
+				"id":      previewID,
+				"type":    "PreviewApiKey",
+				"version": 1,
+				"space":   fakeLink("Space", segments[1]),
+			},
+			"accessToken": "preview-token-" + id,
+		})
+		obj["accessToken"] = "delivery-token-" + id
+		obj["preview_api_key"] = fakeLink("PreviewApiKey", previewID)
+		if _, ok := obj["environments"]; !ok {
+			obj["environments"] = []interface{}{fakeLink("Environment", "master")}
+		}
+	}
+
+	obj["sys"] = sys
+	s.store(path, obj)
+	writeFakeJSON(w, http.StatusCreated, obj)
+}
+
+// createEditorInterface creates the editor interface of a content type with
+// the default control of every field, like Contentful does on activation.
+func (s *fakeServer) createEditorInterface(path string, ct map[string]interface{}) {
+	if _, ok := s.objects[path+"/editor_interface"]; ok {
+		return
+	}
+
+	controls := make([]interface{}, 0)
+	fields, _ := ct["fields"].([]interface{})
+	for _, field := range fields {
+		if field, ok := field.(map[string]interface{}); ok {
+			controls = append(controls, map[string]interface{}{
+				"fieldId":         field["id"],
+				"widgetId":        "singleLine",
+				"widgetNamespace": "builtin",
+			})
+		}
+	}
+
+	ctSys := ct["sys"].(map[string]interface{})
+	s.store(path+"/editor_interface", map[string]interface{}{
+		"controls": controls,
+		"sys": map[string]interface{}{
+			"id":          "default",
+			"type":        "EditorInterface",
+			"version":     1,
+			"space":       ctSys["space"],
+			"environment": ctSys["environment"],
+			"contentType": fakeLink("ContentType", ctSys["id"].(string)),
+		},
+	})
+}
+
+// cloneEnvironment copies the content of the source environment to the
+// environment with the given ID.
+func (s *fakeServer) cloneEnvironment(spacePath, source, id string) {
+	sourcePrefix := spacePath + "/environments/" + source + "/"
+	targetPrefix := spacePath + "/environments/" + id + "/"
+
+	for _, key := range append([]string(nil), s.order...) {
+		obj, ok := s.objects[key]
+		if !ok || !strings.HasPrefix(key, sourcePrefix) {
+			continue
+		}
+
+		clone := copyObject(obj)
+		sys := clone["sys"].(map[string]interface{})
+		sys["environment"] = fakeLink("Environment", id)
+		// Versions are kept as int, which doesn't survive the JSON round trip.
+		for _, key := range []string{"version", "publishedVersion", "publishedCounter"} {
+			if v, ok := sys[key].(float64); ok {
+				sys[key] = int(v)
+			}
+		}
+		s.store(targetPrefix+strings.TrimPrefix(key, sourcePrefix), clone)
+	}
+}
+
+func (s *fakeServer) createSpace(id string, body map[string]interface{}) map[string]interface{} {
+	defaultLocale, _ := body["defaultLocale"].(string)
+	if defaultLocale == "" {
+		defaultLocale = "en-US"
+	}
+
+	space := map[string]interface{}{
+		"name": body["name"],
+		"sys": map[string]interface{}{
+			"id":        id,
+			"type":      "Space",
+			"version":   1,
+			"createdAt": fakeNow(),
+		},
+	}
+	s.store("spaces/"+id, space)
+
+	s.store("spaces/"+id+"/environments/master", map[string]interface{}{
+		"name": "master",
+		"sys": map[string]interface{}{
+			"id":        "master",
+			"type":      "Environment",
+			"version":   1,
+			"createdAt": fakeNow(),
+			"space":     fakeLink("Space", id),
+			"status":    fakeLink("Status", "ready"),
+		},
+	})
+
+	s.store("spaces/"+id+"/environment_aliases/master", map[string]interface{}{
+		"environment": fakeLink("Environment", "master"),
+		"sys": map[string]interface{}{
+			"id":        "master",
+			"type":      "EnvironmentAlias",
+			"version":   1,
+			"createdAt": fakeNow(),
+			"space":     fakeLink("Space", id),
+		},
+	})
+
+	// The locales of the space are the locales of the master environment.
+	s.nextID++
+	localeID := fmt.Sprintf("fake%d", s.nextID)
+	for _, parent := range []string{"spaces/" + id, "spaces/" + id + "/environments/master"} {
+		s.store(parent+"/locales/"+localeID, map[string]interface{}{
+			"name":                 defaultLocale,
+			"code":                 defaultLocale,
+			"default":              true,
+			"contentDeliveryApi":   true,
+			"contentManagementApi": true,
+			"sys": map[string]interface{}{
+				"id":        localeID,
+				"type":      "Locale",
+				"version":   1,
+				"createdAt": fakeNow(),
+				"space":     fakeLink("Space", id),
+			},
+		})
+	}
+
+	return space
+}
+
+func (s *fakeServer) store(path string, obj map[string]interface{}) {
+	if _, ok := s.objects[path]; !ok {
+		s.order = append(s.order, path)
+	}
+	s.objects[path] = obj
+}
+
+// checkFakeVersion writes a VersionMismatch error if the request carries an
+// X-Contentful-Version header which doesn't match the object.
+func checkFakeVersion(w http.ResponseWriter, r *http.Request, obj map[string]interface{}) bool {
+	header := r.Header.Get("X-Contentful-Version")
+	if header == "" {
+		return true
+	}
+
+	version, err := strconv.Atoi(header)
+	current := obj["sys"].(map[string]interface{})["version"].(int)
+	if err != nil || version != current {
+		writeFakeError(w, http.StatusConflict, "VersionMismatch", fmt.Sprintf("expected version %d, got %s", current, header))
+		return false
+	}
+	return true
+}
+
+func bumpVersion(obj map[string]interface{}) {
+	sys := obj["sys"].(map[string]interface{})
+	sys["version"] = sys["version"].(int) + 1
+	sys["updatedAt"] = fakeNow()
+}
+
+func fakeLink(linkType, id string) map[string]interface{} {
+	return map[string]interface{}{
+		"sys": map[string]interface{}{
+			"type":     "Link",
+			"linkType": linkType,
+			"id":       id,
+		},
+	}
+}
+
+// fakeLinkID returns the ID of a link decoded from a request body.
+func fakeLinkID(link interface{}) string {
+	l, _ := link.(map[string]interface{})
+	sys, _ := l["sys"].(map[string]interface{})
+	id, _ := sys["id"].(string)
+	return id
+}
+
+func fakeNow() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
+
+func copyObject(obj map[string]interface{}) map[string]interface{} {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(b, &result); err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, v interface{}) {
+	if obj, ok := v.(map[string]interface{}); ok {
+		v = hideFakeSecrets(obj)
+	}
+
+	w.Header().Set("Content-Type", "application/vnd.contentful.management.v1+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// hideFakeSecrets removes the values of secret webhook headers from a
+// response. The stored object keeps them, so tests can check what was sent.
+func hideFakeSecrets(obj map[string]interface{}) map[string]interface{} {
+	if _, ok := obj["headers"].([]interface{}); !ok {
+		return obj
+	}
+
+	obj = copyObject(obj)
+	for _, header := range obj["headers"].([]interface{}) {
+		if header, ok := header.(map[string]interface{}); ok && header["secret"] == true {
+			delete(header, "value")
+		}
+	}
+	return obj
+}
+
+func writeFakeNotFound(w http.ResponseWriter) {
+	writeFakeError(w, http.StatusNotFound, "NotFound", "The resource could not be found.")
+}
+
+func writeFakeValidationError(w http.ResponseWriter, path, details string) {
+	writeFakeJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{
+		"sys":     map[string]interface{}{"type": "Error", "id": "ValidationFailed"},
+		"message": "Validation error",
+		"details": map[string]interface{}{
+			"errors": []interface{}{
+				map[string]interface{}{
+					"name":    "invalid",
+					"path":    strings.Split(path, "."),
+					"details": details,
+				},
+			},
+		},
+	})
+}
+
+func writeFakeError(w http.ResponseWriter, status int, id, message string) {
+	writeFakeJSON(w, status, map[string]interface{}{
+		"sys":     map[string]interface{}{"type": "Error", "id": id},
+		"message": message,
+		"details": map[string]interface{}{},
+	})
+}
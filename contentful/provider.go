@@ -2,10 +2,11 @@ package contentful
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	contentful "github.com/kitagry/contentful-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Provider returns the Terraform Provider as a scheme and makes resources reachable
@@ -15,6 +16,7 @@ func Provider() *schema.Provider {
 			"cma_token": {
 				Type:        schema.TypeString,
 				Required:    true,
+				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("CONTENTFUL_MANAGEMENT_TOKEN", nil),
 				Description: "The Contentful Management API token",
 			},
@@ -24,16 +26,75 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("CONTENTFUL_ORGANIZATION_ID", nil),
 				Description: "The organization ID",
 			},
+			"base_url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("CONTENTFUL_BASE_URL", defaultBaseURL),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
+				Description:      "The base URL of the Content Management API, e.g. https://api.eu.contentful.com for the EU data residency region",
+			},
+			"upload_url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("CONTENTFUL_UPLOAD_URL", defaultUploadURL),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
+				Description:      "The base URL of the Upload API, e.g. https://upload.eu.contentful.com for the EU data residency region",
+			},
+			"user_agent": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The User-Agent header sent with every request",
+			},
+			"request_timeout": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "The timeout of a single request in seconds. Every retry gets the full timeout again, and the wait between retries doesn't count against it. 0 means no timeout",
+			},
+			"proxy_url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithScheme([]string{"http", "https", "socks5"})),
+				Description:      "The URL of the proxy used for all requests. Defaults to the proxy from the HTTP_PROXY/HTTPS_PROXY environment variables",
+			},
+			"max_retries": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          defaultMaxRetries,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "The maximum number of retries of a request which was rate limited, or which failed with a server error and is not a POST",
+			},
+			"max_rate_per_second": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "The maximum number of requests sent per second. 0 means no limit",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"contentful_space":       resourceContentfulSpace(),
-			"contentful_contenttype": resourceContentfulContentType(),
-			"contentful_apikey":      resourceContentfulAPIKey(),
-			"contentful_webhook":     resourceContentfulWebhook(),
-			"contentful_locale":      resourceContentfulLocale(),
-			"contentful_environment": resourceContentfulEnvironment(),
-			"contentful_entry":       resourceContentfulEntry(),
-			"contentful_asset":       resourceContentfulAsset(),
+			"contentful_space":                 resourceContentfulSpace(),
+			"contentful_contenttype":           resourceContentfulContentType(),
+			"contentful_apikey":                resourceContentfulAPIKey(),
+			"contentful_webhook":               resourceContentfulWebhook(),
+			"contentful_locale":                resourceContentfulLocale(),
+			"contentful_environment":           resourceContentfulEnvironment(),
+			"contentful_environment_alias":     resourceContentfulEnvironmentAlias(),
+			"contentful_entry":                 resourceContentfulEntry(),
+			"contentful_asset":                 resourceContentfulAsset(),
+			"contentful_editor_interface":      resourceContentfulEditorInterface(),
+			"contentful_role":                  resourceContentfulRole(),
+			"contentful_space_membership":      resourceContentfulSpaceMembership(),
+			"contentful_team_space_membership": resourceContentfulTeamSpaceMembership(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"contentful_space":       dataSourceContentfulSpace(),
+			"contentful_environment": dataSourceContentfulEnvironment(),
+			"contentful_contenttype": dataSourceContentfulContentType(),
+			"contentful_locale":      dataSourceContentfulLocale(),
+			"contentful_entry":       dataSourceContentfulEntry(),
+			"contentful_asset":       dataSourceContentfulAsset(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -41,12 +102,22 @@ func Provider() *schema.Provider {
 
 // providerConfigure sets the configuration for the Terraform Provider
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	cma := contentful.NewCMA(d.Get("cma_token").(string))
-	cma.SetOrganization(d.Get("organization_id").(string))
+	client, err := newProviderClient(clientConfig{
+		token:          d.Get("cma_token").(string),
+		organizationID: d.Get("organization_id").(string),
+		baseURL:        d.Get("base_url").(string),
+		uploadURL:      d.Get("upload_url").(string),
+		userAgent:      d.Get("user_agent").(string),
+		timeout:        time.Duration(d.Get("request_timeout").(int)) * time.Second,
+		proxyURL:       d.Get("proxy_url").(string),
+		debug:          logBoolean != "",
 
-	if logBoolean != "" {
-		cma.Debug = true
+		maxRetries:       d.Get("max_retries").(int),
+		maxRatePerSecond: d.Get("max_rate_per_second").(int),
+	})
+	if err != nil {
+		return nil, diag.FromErr(err)
 	}
 
-	return cma, nil
+	return client, nil
 }
@@ -1,9 +1,16 @@
 package contentful
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 var (
@@ -28,6 +35,78 @@ func TestProvider_impl(t *testing.T) {
 	_ = Provider()
 }
 
+func TestProviderConfigure(t *testing.T) {
+	type request struct {
+		host      string
+		path      string
+		userAgent string
+	}
+
+	var got []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, request{host: r.Host, path: r.URL.Path, userAgent: r.Header.Get("User-Agent")})
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"sys":{"id":"space-id","type":"Space"},"name":"space"}`)
+	}))
+	defer server.Close()
+
+	tests := map[string]struct {
+		config map[string]interface{}
+		want   request
+	}{
+		"base_url": {
+			config: map[string]interface{}{
+				"base_url":   server.URL,
+				"user_agent": "my-pipeline/1.0",
+			},
+			want: request{
+				host:      strings.TrimPrefix(server.URL, "http://"),
+				path:      "/spaces/space-id",
+				userAgent: "my-pipeline/1.0",
+			},
+		},
+		"proxy_url": {
+			config: map[string]interface{}{
+				"base_url":  "http://api.contentful.invalid",
+				"proxy_url": server.URL,
+			},
+			want: request{
+				host:      "api.contentful.invalid",
+				path:      "/spaces/space-id",
+				userAgent: "Go-http-client/1.1",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got = nil
+
+			config := map[string]interface{}{
+				"cma_token":       "token",
+				"organization_id": "organization",
+			}
+			for k, v := range tt.config {
+				config[k] = v
+			}
+
+			d := schema.TestResourceDataRaw(t, Provider().Schema, config)
+			meta, diags := providerConfigure(context.Background(), d)
+			if diags.HasError() {
+				t.Fatalf("providerConfigure returned an error: %v", diags)
+			}
+
+			if _, err := meta.(*providerClient).Spaces.Get(context.Background(), "space-id"); err != nil {
+				t.Fatalf("failed to get space: %v", err)
+			}
+
+			if len(got) != 1 || got[0] != tt.want {
+				t.Errorf("unexpected requests: got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	var cmaToken, organizationID string
 	if cmaToken = CMAToken; cmaToken == "" {
@@ -43,3 +122,22 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("ENV_ID must set with a valid Contentful Environment ID for acceptance tests")
 	}
 }
+
+// testAccImportStateIDFunc builds a composite import ID from the given
+// attributes of the resource followed by its ID, e.g. "space_id/env_id/id".
+func testAccImportStateIDFunc(n string, attrs ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not Found: %s", n)
+		}
+
+		parts := make([]string, 0, len(attrs)+1)
+		for _, attr := range attrs {
+			parts = append(parts, rs.Primary.Attributes[attr])
+		}
+		parts = append(parts, rs.Primary.ID)
+
+		return strings.Join(parts, "/"), nil
+	}
+}
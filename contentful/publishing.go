@@ -0,0 +1,105 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	contentful "github.com/kitagry/contentful-go"
+)
+
+// Statuses of entries and assets, like in the web app.
+const (
+	publishingStatusDraft     = "draft"
+	publishingStatusChanged   = "changed"
+	publishingStatusPublished = "published"
+	publishingStatusArchived  = "archived"
+)
+
+// Actions which change the status of entries and assets.
+const (
+	publishingActionPublish   = "publish"
+	publishingActionUnpublish = "unpublish"
+	publishingActionArchive   = "archive"
+	publishingActionUnarchive = "unarchive"
+)
+
+// publishingStatus returns the status of an entry or asset. Every update
+// increments the version, so a published item whose version is ahead of the
+// published one by more than the publication itself has unpublished changes.
+func publishingStatus(sys *contentful.Sys) string {
+	switch {
+	case sys.ArchivedAt != "":
+		return publishingStatusArchived
+	case sys.PublishedAt == "":
+		return publishingStatusDraft
+	case sys.Version > sys.PublishedVersion+1:
+		return publishingStatusChanged
+	default:
+		return publishingStatusPublished
+	}
+}
+
+// nextPublishingAction returns the action which moves an entry or asset from
+// status towards the configured state, or "" if it is already there.
+// Archived items have to be unarchived before they can be published, and
+// published items have to be unpublished before they can be archived.
+func nextPublishingAction(status string, published, archived bool) string {
+	switch {
+	case archived:
+		switch status {
+		case publishingStatusArchived:
+			return ""
+		case publishingStatusPublished, publishingStatusChanged:
+			return publishingActionUnpublish
+		default:
+			return publishingActionArchive
+		}
+	case status == publishingStatusArchived:
+		return publishingActionUnarchive
+	case published:
+		if status == publishingStatusPublished {
+			return ""
+		}
+		return publishingActionPublish
+	case status == publishingStatusPublished || status == publishingStatusChanged:
+		return publishingActionUnpublish
+	default:
+		return ""
+	}
+}
+
+// changePublishingStatus calls the actions which are needed to reach the
+// configured state. Every action changes the version of the item, so it is
+// read with get again before the next action. The first error is returned,
+// since the following actions depend on it.
+func changePublishingStatus(published, archived bool, get func() (*contentful.Sys, error), actions map[string]func() error) error {
+	// At most two actions are needed, e.g. unpublish and archive.
+	for i := 0; i < 3; i++ {
+		sys, err := get()
+		if err != nil {
+			return err
+		}
+
+		action := nextPublishingAction(publishingStatus(sys), published, archived)
+		if action == "" {
+			return nil
+		}
+
+		if err := actions[action](); err != nil {
+			return err
+		}
+	}
+
+	return fmt.Errorf("status didn't change to the configured one")
+}
+
+// validatePublishingState rejects configurations which can't be reached,
+// since archived entries and assets can't be published.
+func validatePublishingState(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("published").(bool) && d.Get("archived").(bool) {
+		return errors.New("published and archived can't both be true")
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package contentful
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	contentful "github.com/kitagry/contentful-go"
+)
+
+func TestPublishingStatus(t *testing.T) {
+	tests := map[string]struct {
+		sys    *contentful.Sys
+		expect string
+	}{
+		"draft": {
+			sys:    &contentful.Sys{Version: 3},
+			expect: publishingStatusDraft,
+		},
+		"published": {
+			sys:    &contentful.Sys{Version: 4, PublishedAt: "2022-01-01T00:00:00Z", PublishedVersion: 3},
+			expect: publishingStatusPublished,
+		},
+		"changed": {
+			sys:    &contentful.Sys{Version: 5, PublishedAt: "2022-01-01T00:00:00Z", PublishedVersion: 3},
+			expect: publishingStatusChanged,
+		},
+		"archived": {
+			sys:    &contentful.Sys{Version: 5, ArchivedAt: "2022-01-01T00:00:00Z", ArchivedVersion: 4},
+			expect: publishingStatusArchived,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := publishingStatus(tt.sys); got != tt.expect {
+				t.Errorf("expected %q, got %q", tt.expect, got)
+			}
+		})
+	}
+}
+
+// fakePublishable keeps the sys of an item and changes it like Contentful.
+type fakePublishable struct {
+	sys     *contentful.Sys
+	actions []string
+	fail    map[string]error
+}
+
+func (p *fakePublishable) get() (*contentful.Sys, error) {
+	sys := *p.sys
+	return &sys, nil
+}
+
+func (p *fakePublishable) do(action string) func() error {
+	return func() error {
+		p.actions = append(p.actions, action)
+		if err := p.fail[action]; err != nil {
+			return err
+		}
+
+		status := publishingStatus(p.sys)
+		switch action {
+		case publishingActionPublish:
+			if status == publishingStatusArchived {
+				return errors.New("can't publish an archived item")
+			}
+			p.sys.PublishedAt = "now"
+			p.sys.PublishedVersion = p.sys.Version
+		case publishingActionUnpublish:
+			p.sys.PublishedAt = ""
+			p.sys.PublishedVersion = 0
+		case publishingActionArchive:
+			if status == publishingStatusPublished || status == publishingStatusChanged {
+				return errors.New("can't archive a published item")
+			}
+			p.sys.ArchivedAt = "now"
+		case publishingActionUnarchive:
+			p.sys.ArchivedAt = ""
+		}
+		p.sys.Version++
+		return nil
+	}
+}
+
+func (p *fakePublishable) actionFuncs() map[string]func() error {
+	return map[string]func() error{
+		publishingActionPublish:   p.do(publishingActionPublish),
+		publishingActionUnpublish: p.do(publishingActionUnpublish),
+		publishingActionArchive:   p.do(publishingActionArchive),
+		publishingActionUnarchive: p.do(publishingActionUnarchive),
+	}
+}
+
+func TestChangePublishingStatus(t *testing.T) {
+	draft := contentful.Sys{Version: 1}
+	published := contentful.Sys{Version: 2, PublishedAt: "now", PublishedVersion: 1}
+	changed := contentful.Sys{Version: 3, PublishedAt: "now", PublishedVersion: 1}
+	archived := contentful.Sys{Version: 2, ArchivedAt: "now"}
+
+	tests := map[string]struct {
+		sys           contentful.Sys
+		published     bool
+		archived      bool
+		expectActions []string
+		expectStatus  string
+	}{
+		"publish draft": {
+			sys: draft, published: true,
+			expectActions: []string{publishingActionPublish},
+			expectStatus:  publishingStatusPublished,
+		},
+		"publish changes": {
+			sys: changed, published: true,
+			expectActions: []string{publishingActionPublish},
+			expectStatus:  publishingStatusPublished,
+		},
+		"keep published": {
+			sys: published, published: true,
+			expectStatus: publishingStatusPublished,
+		},
+		"unpublish": {
+			sys:           published,
+			expectActions: []string{publishingActionUnpublish},
+			expectStatus:  publishingStatusDraft,
+		},
+		"archive published": {
+			sys: published, archived: true,
+			expectActions: []string{publishingActionUnpublish, publishingActionArchive},
+			expectStatus:  publishingStatusArchived,
+		},
+		"publish archived": {
+			sys: archived, published: true,
+			expectActions: []string{publishingActionUnarchive, publishingActionPublish},
+			expectStatus:  publishingStatusPublished,
+		},
+		"unarchive": {
+			sys:           archived,
+			expectActions: []string{publishingActionUnarchive},
+			expectStatus:  publishingStatusDraft,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			sys := tt.sys
+			p := &fakePublishable{sys: &sys}
+			if err := changePublishingStatus(tt.published, tt.archived, p.get, p.actionFuncs()); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.expectActions, p.actions); diff != "" {
+				t.Errorf("actions diff (-expect, +got)\n%s", diff)
+			}
+			if got := publishingStatus(p.sys); got != tt.expectStatus {
+				t.Errorf("expected status %q, got %q", tt.expectStatus, got)
+			}
+		})
+	}
+
+	t.Run("failed action", func(t *testing.T) {
+		sys := published
+		failure := errors.New("unpublish failed")
+		p := &fakePublishable{sys: &sys, fail: map[string]error{publishingActionUnpublish: failure}}
+		err := changePublishingStatus(false, true, p.get, p.actionFuncs())
+		if err != failure {
+			t.Errorf("expected the error of unpublish, got %v", err)
+		}
+		if diff := cmp.Diff([]string{publishingActionUnpublish}, p.actions); diff != "" {
+			t.Errorf("actions after a failure diff (-expect, +got)\n%s", diff)
+		}
+	})
+
+	t.Run("failed get", func(t *testing.T) {
+		failure := errors.New("get failed")
+		get := func() (*contentful.Sys, error) { return nil, failure }
+		if err := changePublishingStatus(true, false, get, nil); err != failure {
+			t.Errorf("expected the error of get, got %v", err)
+		}
+	})
+}
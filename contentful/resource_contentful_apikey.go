@@ -2,6 +2,7 @@ package contentful
 
 import (
 	"context"
+	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,6 +15,10 @@ func resourceContentfulAPIKey() *schema.Resource {
 		ReadContext:   wrapApiKey(resourceReadAPIKey),
 		UpdateContext: wrapApiKey(resourceUpdateAPIKey),
 		DeleteContext: wrapApiKey(resourceDeleteAPIKey),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportAPIKey,
+		},
+		CustomizeDiff: diffAPIKeyEnvironments,
 
 		Schema: map[string]*schema.Schema{
 			"version": {
@@ -21,8 +26,15 @@ func resourceContentfulAPIKey() *schema.Resource {
 				Computed: true,
 			},
 			"access_token": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"preview_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The access token of the Content Preview API",
 			},
 			"space_id": {
 				Type:     schema.TypeString,
@@ -36,21 +48,29 @@ func resourceContentfulAPIKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"environments": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the environments the key has access to. Environment aliases are not supported. Defaults to master, also when it is removed from the configuration",
+			},
 		},
 	}
 }
 
 func wrapApiKey(f func(ctx context.Context, d *schema.ResourceData, apiKey ContentfulAPIKeyClient) diag.Diagnostics) func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
-		client := m.(*contentful.Client)
-		return f(ctx, d, client.APIKeys)
+		client := m.(*providerClient)
+		return f(ctx, d, client.apiKeys)
 	}
 }
 
 func resourceCreateAPIKey(ctx context.Context, d *schema.ResourceData, client ContentfulAPIKeyClient) (diags diag.Diagnostics) {
 	apiKey := &contentful.APIKey{
-		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		Environments: newAPIKeyEnvironments(d),
 	}
 
 	err := client.Upsert(ctx, d.Get("space_id").(string), apiKey)
@@ -59,7 +79,7 @@ func resourceCreateAPIKey(ctx context.Context, d *schema.ResourceData, client Co
 		return
 	}
 
-	if err := setAPIKeyProperties(d, apiKey); err != nil {
+	if err := setAPIKeyProperties(ctx, d, client, apiKey); err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
 	}
@@ -81,6 +101,9 @@ func resourceUpdateAPIKey(ctx context.Context, d *schema.ResourceData, client Co
 
 	apiKey.Name = d.Get("name").(string)
 	apiKey.Description = d.Get("description").(string)
+	if environments := newAPIKeyEnvironments(d); environments != nil {
+		apiKey.Environments = environments
+	}
 
 	err = client.Upsert(ctx, spaceID, apiKey)
 	if err != nil {
@@ -88,7 +111,7 @@ func resourceUpdateAPIKey(ctx context.Context, d *schema.ResourceData, client Co
 		return
 	}
 
-	if err := setAPIKeyProperties(d, apiKey); err != nil {
+	if err := setAPIKeyProperties(ctx, d, client, apiKey); err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
 	}
@@ -107,8 +130,12 @@ func resourceReadAPIKey(ctx context.Context, d *schema.ResourceData, client Cont
 		d.SetId("")
 		return nil
 	}
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
 
-	err = setAPIKeyProperties(d, apiKey)
+	err = setAPIKeyProperties(ctx, d, client, apiKey)
 	if err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
@@ -134,7 +161,29 @@ func resourceDeleteAPIKey(ctx context.Context, d *schema.ResourceData, client Co
 	return
 }
 
-func setAPIKeyProperties(d *schema.ResourceData, apiKey *contentful.APIKey) error {
+func resourceImportAPIKey(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ids, err := parseImportID(d.Id(), "space_id", "apikey_id")
+	if err != nil {
+		return nil, err
+	}
+
+	client := m.(*providerClient)
+
+	apiKey, err := client.apiKeys.Get(ctx, ids[0], ids[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setAPIKeyProperties(ctx, d, client.apiKeys, apiKey); err != nil {
+		return nil, err
+	}
+
+	d.SetId(apiKey.Sys.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setAPIKeyProperties(ctx context.Context, d *schema.ResourceData, client ContentfulAPIKeyClient, apiKey *contentful.APIKey) error {
 	if err := d.Set("space_id", apiKey.Sys.Space.Sys.ID); err != nil {
 		return err
 	}
@@ -155,5 +204,62 @@ func setAPIKeyProperties(d *schema.ResourceData, apiKey *contentful.APIKey) erro
 		return err
 	}
 
+	environments := make([]interface{}, 0, len(apiKey.Environments))
+	for _, environment := range apiKey.Environments {
+		environments = append(environments, environment.Sys.ID)
+	}
+	if err := d.Set("environments", environments); err != nil {
+		return err
+	}
+
+	previewToken := ""
+	if previewAPIKeyID := apiKey.PreviewAPIKey.Sys.ID; previewAPIKeyID != "" {
+		previewAPIKey, err := client.GetPreviewAPIKey(ctx, apiKey.Sys.Space.Sys.ID, previewAPIKeyID)
+		if err != nil {
+			return err
+		}
+		previewToken = previewAPIKey.AccessToken
+	}
+	if err := d.Set("preview_token", previewToken); err != nil {
+		return err
+	}
+
 	return nil
 }
+
+// diffAPIKeyEnvironments plans the default environment when environments are
+// removed from the configuration of an existing key. Otherwise the computed
+// environments of the state would be kept.
+func diffAPIKeyEnvironments(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	config := d.GetRawConfig()
+	if d.Id() == "" || config.IsNull() || !config.GetAttr("environments").IsNull() {
+		return nil
+	}
+
+	environments := []interface{}{defaultEnvironmentID}
+	if reflect.DeepEqual(d.Get("environments"), environments) {
+		return nil
+	}
+	return d.SetNew("environments", environments)
+}
+
+// newAPIKeyEnvironments returns links to the configured environments, or nil
+// if they aren't configured.
+func newAPIKeyEnvironments(d *schema.ResourceData) []contentful.Environments {
+	ids, ok := d.GetOk("environments")
+	if !ok {
+		return nil
+	}
+
+	environments := make([]contentful.Environments, 0, len(ids.([]interface{})))
+	for _, id := range ids.([]interface{}) {
+		environments = append(environments, contentful.Environments{
+			Sys: contentful.Sys{
+				ID:       id.(string),
+				Type:     "Link",
+				LinkType: "Environment",
+			},
+		})
+	}
+	return environments
+}
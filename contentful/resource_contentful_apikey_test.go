@@ -0,0 +1,81 @@
+package contentful
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceAPIKey_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+	createFakeEnvironment(t, meta, "space", "staging")
+
+	r := resourceContentfulAPIKey()
+
+	// Contentful assigns master if no environments are configured.
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:   "create",
+			config: map[string]interface{}{"space_id": "space", "name": "default"},
+			check: func(t *testing.T, d *schema.ResourceData) {
+				checkFakeAttributes(t, d, map[string]interface{}{"environments": []interface{}{"master"}})
+			},
+		},
+	})
+
+	frontend := func(environments ...interface{}) map[string]interface{} {
+		raw := map[string]interface{}{"space_id": "space", "name": "frontend"}
+		if environments != nil {
+			raw["environments"] = environments
+		}
+		return raw
+	}
+	checkTokens := func(t *testing.T, d *schema.ResourceData, environments ...interface{}) {
+		t.Helper()
+
+		checkFakeAttributes(t, d, map[string]interface{}{
+			"access_token":  "delivery-token-" + d.Id(),
+			"preview_token": "preview-token-" + d.Id(),
+			"environments":  environments,
+		})
+	}
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:   "create",
+			config: frontend("master", "staging"),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				checkTokens(t, d, "master", "staging")
+			},
+		},
+		// Updates keep the tokens and change the environments.
+		{
+			name:   "update",
+			config: frontend("staging"),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				checkTokens(t, d, "staging")
+			},
+		},
+		{
+			name: "read",
+			check: func(t *testing.T, d *schema.ResourceData) {
+				checkTokens(t, d, "staging")
+			},
+		},
+		// Removing environments from the configuration restores the default.
+		{
+			name:   "remove environments",
+			config: frontend(),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				checkTokens(t, d, "master")
+
+				environments := server.object("spaces/space/api_keys/" + d.Id())["environments"]
+				if diff := cmp.Diff([]interface{}{fakeLink("Environment", "master")}, environments); diff != "" {
+					t.Errorf("sent environments diff (-expect, +got)\n%s", diff)
+				}
+			},
+		},
+		{name: "plan", config: frontend(), planOnly: true},
+	})
+}
@@ -1,19 +1,47 @@
 package contentful
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	contentful "github.com/kitagry/contentful-go"
 )
 
+const (
+	assetStatusProcessing = "processing"
+	assetStatusProcessed  = "processed"
+)
+
+// assetProcessingPollInterval is how often the asset is fetched while its files
+// are processed. It is shortened in tests.
+var assetProcessingPollInterval = time.Second
+
 func resourceContentfulAsset() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: wrapAsset(resourceCreateAsset),
 		ReadContext:   wrapAsset(resourceReadAsset),
 		UpdateContext: wrapAsset(resourceUpdateAsset),
 		DeleteContext: wrapAsset(resourceDeleteAsset),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportAsset,
+		},
+		CustomizeDiff: customdiff.All(diffAssetContentHashes, validatePublishingState),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"asset_id": {
@@ -32,6 +60,13 @@ func resourceContentfulAsset() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"env_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the environment. Defaults to master",
+			},
 			"fields": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -70,39 +105,67 @@ func resourceContentfulAsset() *schema.Resource {
 							},
 						},
 						"file": {
-							Type:     schema.TypeSet,
-							Required: true,
+							Type:        schema.TypeSet,
+							Required:    true,
+							Description: "One file per locale",
+							Set:         hashAssetFile,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"locale": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The locale of the file. Defaults to locale",
+									},
 									"url": {
-										Type:     schema.TypeString,
-										Optional: true,
+										Type:        schema.TypeString,
+										Optional:    true,
+										Computed:    true,
+										Description: "The URL of the processed file",
+										Deprecated:  "url is set from the processed file. Use upload, source_path or content_base64 to change the file",
 									},
 									"upload": {
 										Type:     schema.TypeString,
 										Optional: true,
 									},
+									"source_path": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The path of a local file which is sent to the Upload API",
+									},
+									"content_base64": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsBase64,
+										Description:  "The base64 encoded content which is sent to the Upload API",
+									},
 									"details": {
-										Type:     schema.TypeSet,
-										Optional: true,
+										Type:        schema.TypeList,
+										Optional:    true,
+										Computed:    true,
+										Description: "The details of the processed file",
+										Deprecated:  "details are set from the processed file and configured values are ignored",
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"size": {
 													Type:     schema.TypeInt,
-													Required: true,
+													Optional: true,
+													Computed: true,
 												},
 												"image": {
-													Type:     schema.TypeSet,
-													Required: true,
+													Type:     schema.TypeList,
+													Optional: true,
+													Computed: true,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															"width": {
 																Type:     schema.TypeInt,
-																Required: true,
+																Optional: true,
+																Computed: true,
 															},
 															"height": {
 																Type:     schema.TypeInt,
-																Required: true,
+																Optional: true,
+																Computed: true,
 															},
 														},
 													},
@@ -124,51 +187,54 @@ func resourceContentfulAsset() *schema.Resource {
 					},
 				},
 			},
+			"content_hashes": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The SHA-256 of the uploaded content of every locale",
+			},
 			"published": {
-				Type:     schema.TypeBool,
-				Required: true,
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether the asset is published. Changes applied by Terraform are published as well, and it is false while the asset has unpublished changes",
 			},
 			"archived": {
-				Type:     schema.TypeBool,
-				Required: true,
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether the asset is archived. It can't be published at the same time",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the asset: draft, changed, published or archived",
 			},
 		},
 	}
 }
 
-func wrapAsset(f func(ctx context.Context, d *schema.ResourceData, client ContentfulAssetClient) diag.Diagnostics) func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+func wrapAsset(f func(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulAssetClient) diag.Diagnostics) func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
-		client := m.(*contentful.Client)
-		return f(ctx, d, client.Assets)
+		client := m.(*providerClient)
+		spaceID := d.Get("space_id").(string)
+		envID := d.Get("env_id").(string)
+		if envID == "" {
+			envID = defaultEnvironmentID
+		}
+		env, err := client.Environments.Get(ctx, spaceID, envID)
+		if err != nil {
+			diags = append(diags, contentfulErrorToDiagnostic(err)...)
+			return
+		}
+		return f(ctx, d, env, client.assets)
 	}
 }
 
-func resourceCreateAsset(ctx context.Context, d *schema.ResourceData, client ContentfulAssetClient) (diags diag.Diagnostics) {
-	fields := d.Get("fields").([]interface{})[0].(map[string]interface{})
-
-	localizedTitle := map[string]string{}
-	rawTitle := fields["title"].([]interface{})
-	for i := 0; i < len(rawTitle); i++ {
-		field := rawTitle[i].(map[string]interface{})
-		localizedTitle[field["locale"].(string)] = field["content"].(string)
-	}
-
-	localizedDescription := map[string]string{}
-	rawDescription := fields["description"].([]interface{})
-	for i := 0; i < len(rawDescription); i++ {
-		field := rawDescription[i].(map[string]interface{})
-		localizedDescription[field["locale"].(string)] = field["content"].(string)
-	}
-
-	files := fields["file"].(*schema.Set).List()
-	if len(files) != 1 {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "file should be single item",
-		})
+func resourceCreateAsset(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulAssetClient) (diags diag.Diagnostics) {
+	fields, err := expandAssetFields(d, nil)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
 	}
-	file := files[0].(map[string]interface{})
 
 	asset := &contentful.Asset{
 		Sys: &contentful.Sys{
@@ -176,37 +242,22 @@ func resourceCreateAsset(ctx context.Context, d *schema.ResourceData, client Con
 			Version: 0,
 		},
 		Locale: d.Get("locale").(string),
-		Fields: &contentful.AssetFields{
-			Title:       localizedTitle,
-			Description: localizedDescription,
-			File: map[string]*contentful.File{
-				d.Get("locale").(string): {
-					FileName:    file["file_name"].(string),
-					ContentType: file["content_type"].(string),
-				},
-			},
-		},
+		Fields: fields,
 	}
 
-	if url, ok := file["url"].(string); ok {
-		asset.Fields.File[d.Get("locale").(string)].URL = url
-	}
-
-	if upload, ok := file["upload"].(string); ok {
-		asset.Fields.File[d.Get("locale").(string)].UploadURL = upload
-	}
-
-	if details, ok := file["details"].(*contentful.FileDetails); ok {
-		asset.Fields.File[d.Get("locale").(string)].Details = details
+	hashes, err := uploadAssetFiles(ctx, d, env, fields, nil, client)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
 	}
 
-	err := client.Upsert(ctx, d.Get("space_id").(string), asset)
+	err = client.Upsert(ctx, env, asset)
 	if err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
 	}
 
-	err = client.Process(ctx, d.Get("space_id").(string), asset)
+	asset, err = processAssetFiles(ctx, env, asset, client, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
@@ -214,12 +265,17 @@ func resourceCreateAsset(ctx context.Context, d *schema.ResourceData, client Con
 
 	d.SetId(asset.Sys.ID)
 
-	if err := setAssetProperties(d, asset); err != nil {
+	if err := d.Set("env_id", env.Sys.ID); err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
 	}
 
-	err = setAssetState(ctx, d, client)
+	if err := d.Set("content_hashes", hashes); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	err = setAssetState(ctx, d, env, client)
 	if err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
@@ -228,8 +284,7 @@ func resourceCreateAsset(ctx context.Context, d *schema.ResourceData, client Con
 	return
 }
 
-func resourceUpdateAsset(ctx context.Context, d *schema.ResourceData, client ContentfulAssetClient) (diags diag.Diagnostics) {
-	spaceID := d.Get("space_id").(string)
+func resourceUpdateAsset(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulAssetClient) (diags diag.Diagnostics) {
 	assetID := d.Id()
 	defer func() {
 		if diags.HasError() {
@@ -237,12 +292,60 @@ func resourceUpdateAsset(ctx context.Context, d *schema.ResourceData, client Con
 		}
 	}()
 
-	asset, err := client.Get(ctx, spaceID, assetID)
+	asset, err := client.Get(ctx, env, assetID)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	current := asset.Fields
+	asset.Locale = d.Get("locale").(string)
+	asset.Fields, err = expandAssetFields(d, current)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	hashes, err := uploadAssetFiles(ctx, d, env, asset.Fields, current, client)
 	if err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
 	}
 
+	err = client.Upsert(ctx, env, asset)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	asset, err = processAssetFiles(ctx, env, asset, client, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	d.SetId(asset.Sys.ID)
+
+	if err := d.Set("content_hashes", hashes); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	err = setAssetState(ctx, d, env, client)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	return
+}
+
+// expandAssetFields converts the "fields" block into the localized fields of
+// an asset. Files without a locale belong to the locale of the asset. Files
+// without upload, source_path or content_base64 keep the processed file of
+// the current fields, unless another url is configured, and so do files whose
+// upload didn't change.
+func expandAssetFields(d *schema.ResourceData, current *contentful.AssetFields) (*contentful.AssetFields, error) {
 	fields := d.Get("fields").([]interface{})[0].(map[string]interface{})
 
 	localizedTitle := map[string]string{}
@@ -259,102 +362,310 @@ func resourceUpdateAsset(ctx context.Context, d *schema.ResourceData, client Con
 		localizedDescription[field["locale"].(string)] = field["content"].(string)
 	}
 
-	files := fields["file"].(*schema.Set).List()
-	if len(files) != 1 {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "file should be single item",
-		})
-		return
+	previousUploads := previousAssetUploads(d)
+	localizedFile := map[string]*contentful.File{}
+	for _, rawFile := range fields["file"].(*schema.Set).List() {
+		file := rawFile.(map[string]interface{})
+
+		locale, _ := file["locale"].(string)
+		if locale == "" {
+			locale = d.Get("locale").(string)
+		}
+		if _, ok := localizedFile[locale]; ok {
+			return nil, fmt.Errorf("file: more than one file has the locale %q", locale)
+		}
+
+		sources := 0
+		for _, key := range []string{"upload", "source_path", "content_base64"} {
+			if file[key].(string) != "" {
+				sources++
+			}
+		}
+		if sources > 1 {
+			return nil, fmt.Errorf("file: only one of upload, source_path and content_base64 can be set for the locale %q", locale)
+		}
+
+		localizedFile[locale] = &contentful.File{
+			FileName:    file["file_name"].(string),
+			ContentType: file["content_type"].(string),
+			UploadURL:   file["upload"].(string),
+		}
+
+		url, _ := file["url"].(string)
+		if current != nil {
+			processed, ok := current.File[locale]
+			if ok && processed.URL != "" && (sources == 0 && (url == "" || url == processed.URL) ||
+				localizedFile[locale].UploadURL != "" && localizedFile[locale].UploadURL == previousUploads[locale]) {
+				processed.FileName = localizedFile[locale].FileName
+				processed.ContentType = localizedFile[locale].ContentType
+				localizedFile[locale] = processed
+				continue
+			}
+		}
+		if sources == 0 {
+			localizedFile[locale].URL = url
+		}
 	}
-	file := files[0].(map[string]interface{})
 
-	asset = &contentful.Asset{
-		Sys: &contentful.Sys{
-			ID:      d.Get("asset_id").(string),
-			Version: d.Get("version").(int),
-		},
-		Locale: d.Get("locale").(string),
-		Fields: &contentful.AssetFields{
-			Title:       localizedTitle,
-			Description: localizedDescription,
-			File: map[string]*contentful.File{
-				d.Get("locale").(string): {
-					FileName:    file["file_name"].(string),
-					ContentType: file["content_type"].(string),
-				},
-			},
-		},
+	return &contentful.AssetFields{
+		Title:       localizedTitle,
+		Description: localizedDescription,
+		File:        localizedFile,
+	}, nil
+}
+
+// previousAssetUploads returns the upload of every locale in the state.
+func previousAssetUploads(d *schema.ResourceData) map[string]string {
+	uploads := map[string]string{}
+	oldFiles, _ := d.GetChange("fields.0.file")
+	files, ok := oldFiles.(*schema.Set)
+	if !ok {
+		return uploads
+	}
+
+	oldLocale, _ := d.GetChange("locale")
+	for _, rawFile := range files.List() {
+		file := rawFile.(map[string]interface{})
+
+		locale, _ := file["locale"].(string)
+		if locale == "" {
+			locale, _ = oldLocale.(string)
+		}
+		uploads[locale], _ = file["upload"].(string)
+	}
+	return uploads
+}
+
+// hashAssetFile identifies a file by its arguments. The url and details are
+// left out, so that the file read from Contentful matches the configured one.
+func hashAssetFile(v interface{}) int {
+	file := v.(map[string]interface{})
+
+	var buf bytes.Buffer
+	for _, key := range []string{"locale", "upload", "source_path", "content_base64", "file_name", "content_type"} {
+		value, _ := file[key].(string)
+		buf.WriteString(value)
+		buf.WriteString("-")
+	}
+	return schema.HashString(buf.String())
+}
+
+// readAssetFileContents reads the content of the files which are sent to the
+// Upload API by locale.
+func readAssetFileContents(files []interface{}, defaultLocale string) (map[string][]byte, error) {
+	contents := map[string][]byte{}
+	for _, rawFile := range files {
+		file := rawFile.(map[string]interface{})
+
+		locale, _ := file["locale"].(string)
+		if locale == "" {
+			locale = defaultLocale
+		}
+
+		if path := file["source_path"].(string); path != "" {
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("file: %w", err)
+			}
+			contents[locale] = content
+		} else if encoded := file["content_base64"].(string); encoded != "" {
+			content, err := base64.StdEncoding.DecodeString(encoded)
+			if err != nil {
+				return nil, fmt.Errorf("file: content_base64 of the locale %q: %w", locale, err)
+			}
+			contents[locale] = content
+		}
 	}
 
-	if url, ok := file["url"].(string); ok {
-		asset.Fields.File[d.Get("locale").(string)].URL = url
+	return contents, nil
+}
+
+func hashAssetFileContents(contents map[string][]byte) map[string]interface{} {
+	hashes := map[string]interface{}{}
+	for locale, content := range contents {
+		hashes[locale] = fmt.Sprintf("%x", sha256.Sum256(content))
 	}
+	return hashes
+}
 
-	if upload, ok := file["upload"].(string); ok {
-		asset.Fields.File[d.Get("locale").(string)].UploadURL = upload
+// diffAssetContentHashes plans a new content hash when a local file changes,
+// so that the file is uploaded again even if its configuration is the same.
+func diffAssetContentHashes(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("fields") || !d.NewValueKnown("locale") {
+		return d.SetNewComputed("content_hashes")
 	}
 
-	if details, ok := file["file_details"].(*contentful.FileDetails); ok {
-		asset.Fields.File[d.Get("locale").(string)].Details = details
+	fields := d.Get("fields").([]interface{})
+	if len(fields) == 0 || fields[0] == nil {
+		return nil
 	}
 
-	err = client.Upsert(ctx, d.Get("space_id").(string), asset)
+	contents, err := readAssetFileContents(fields[0].(map[string]interface{})["file"].(*schema.Set).List(), d.Get("locale").(string))
 	if err != nil {
-		diags = append(diags, contentfulErrorToDiagnostic(err)...)
-		return
+		return err
 	}
 
-	err = client.Process(ctx, d.Get("space_id").(string), asset)
+	hashes := hashAssetFileContents(contents)
+	if reflect.DeepEqual(d.Get("content_hashes"), hashes) {
+		return nil
+	}
+	return d.SetNew("content_hashes", hashes)
+}
+
+// uploadAssetFiles sends the local files to the Upload API and links the
+// uploads to the asset. Files whose content didn't change since the last
+// upload keep the processed file of the current fields. It returns the
+// content hashes of the local files.
+func uploadAssetFiles(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, fields, current *contentful.AssetFields, client ContentfulAssetClient) (map[string]interface{}, error) {
+	contents, err := readAssetFileContents(d.Get("fields.0.file").(*schema.Set).List(), d.Get("locale").(string))
 	if err != nil {
-		diags = append(diags, contentfulErrorToDiagnostic(err)...)
-		return
+		return nil, err
 	}
 
-	d.SetId(asset.Sys.ID)
+	oldHashes, _ := d.GetChange("content_hashes")
+	hashes := hashAssetFileContents(contents)
 
-	if err := setAssetProperties(d, asset); err != nil {
-		diags = append(diags, contentfulErrorToDiagnostic(err)...)
-		return
+	locales := make([]string, 0, len(contents))
+	for locale := range contents {
+		locales = append(locales, locale)
 	}
+	sort.Strings(locales)
 
-	err = setAssetState(ctx, d, client)
-	if err != nil {
-		diags = append(diags, contentfulErrorToDiagnostic(err)...)
-		return
+	for _, locale := range locales {
+		file := fields.File[locale]
+
+		if current != nil && oldHashes.(map[string]interface{})[locale] == hashes[locale] {
+			if processed, ok := current.File[locale]; ok && processed.URL != "" {
+				processed.FileName = file.FileName
+				processed.ContentType = file.ContentType
+				fields.File[locale] = processed
+				continue
+			}
+		}
+
+		upload, err := client.CreateUpload(ctx, env, bytes.NewReader(contents[locale]))
+		if err != nil {
+			return nil, err
+		}
+
+		file.UploadFrom = &contentful.UploadFrom{
+			Sys: &contentful.Sys{
+				ID:       upload.Sys.ID,
+				Type:     "Link",
+				LinkType: "Upload",
+			},
+		}
 	}
 
-	return
+	return hashes, nil
 }
 
-func setAssetState(ctx context.Context, d *schema.ResourceData, client ContentfulAssetClient) (err error) {
-	spaceID := d.Get("space_id").(string)
-	assetID := d.Id()
+// processAssetFiles processes the file of every locale which has a new upload
+// and returns the processed asset. Processing a file changes the version of
+// the asset, so every file is processed after the previous one is done.
+func processAssetFiles(ctx context.Context, env *contentful.Environment, asset *contentful.Asset, client ContentfulAssetClient, timeout time.Duration) (*contentful.Asset, error) {
+	locales := make([]string, 0, len(asset.Fields.File))
+	for locale, file := range asset.Fields.File {
+		if file.UploadURL != "" || file.UploadFrom != nil {
+			locales = append(locales, locale)
+		}
+	}
+	sort.Strings(locales)
 
-	asset, _ := client.Get(ctx, spaceID, assetID)
+	deadline := time.Now().Add(timeout)
+	for _, locale := range locales {
+		if err := client.Process(ctx, env, asset, locale); err != nil {
+			return nil, err
+		}
 
-	if d.Get("published").(bool) && asset.Sys.PublishedAt == "" {
-		err = client.Publish(ctx, spaceID, asset)
-	} else if !d.Get("published").(bool) && asset.Sys.PublishedAt != "" {
-		err = client.Unpublish(ctx, spaceID, asset)
+		var err error
+		asset, err = waitForAssetProcessing(ctx, client, env, asset.Sys.ID, []string{locale}, time.Until(deadline))
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	if d.Get("archived").(bool) && asset.Sys.ArchivedAt == "" {
-		err = client.Archive(ctx, spaceID, asset)
-	} else if !d.Get("archived").(bool) && asset.Sys.ArchivedAt != "" {
-		err = client.Unarchive(ctx, spaceID, asset)
+	return asset, nil
+}
+
+// waitForAssetProcessing polls the asset until the files of the given locales
+// have a url and no upload, and returns the processed asset.
+func waitForAssetProcessing(ctx context.Context, client ContentfulAssetClient, env *contentful.Environment, assetID string, locales []string, timeout time.Duration) (*contentful.Asset, error) {
+	conf := &resource.StateChangeConf{
+		Pending: []string{assetStatusProcessing},
+		Target:  []string{assetStatusProcessed},
+		Refresh: func() (interface{}, string, error) {
+			asset, err := client.Get(ctx, env, assetID)
+			if err != nil {
+				return nil, "", err
+			}
+			for _, locale := range locales {
+				file, ok := asset.Fields.File[locale]
+				if !ok {
+					return nil, "", fmt.Errorf("file of the locale %q was removed while processing", locale)
+				}
+				if file.URL == "" || file.UploadURL != "" || file.UploadFrom != nil {
+					return asset, assetStatusProcessing, nil
+				}
+			}
+			return asset, assetStatusProcessed, nil
+		},
+		Timeout:      timeout,
+		PollInterval: assetProcessingPollInterval,
 	}
 
-	err = setAssetProperties(d, asset)
+	asset, err := conf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return asset.(*contentful.Asset), nil
+}
 
-	return err
+// setAssetState publishes, unpublishes, archives or unarchives the asset
+// until it has the configured state, and sets the resulting properties.
+func setAssetState(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulAssetClient) error {
+	asset, err := changeAssetStatus(ctx, env, client, d.Id(), d.Get("published").(bool), d.Get("archived").(bool))
+	if err != nil {
+		return err
+	}
+
+	if err := setAssetProperties(d, asset); err != nil {
+		return err
+	}
+
+	return setAssetFields(d, asset)
+}
+
+// changeAssetStatus changes the status of the asset to the given state and
+// returns the asset afterwards.
+func changeAssetStatus(ctx context.Context, env *contentful.Environment, client ContentfulAssetClient, assetID string, published, archived bool) (*contentful.Asset, error) {
+	var asset *contentful.Asset
+	get := func() (*contentful.Sys, error) {
+		var err error
+		asset, err = client.Get(ctx, env, assetID)
+		if err != nil {
+			return nil, err
+		}
+		return asset.Sys, nil
+	}
+
+	err := changePublishingStatus(published, archived, get, map[string]func() error{
+		publishingActionPublish:   func() error { return client.Publish(ctx, env, asset) },
+		publishingActionUnpublish: func() error { return client.Unpublish(ctx, env, asset) },
+		publishingActionArchive:   func() error { return client.Archive(ctx, env, asset) },
+		publishingActionUnarchive: func() error { return client.Unarchive(ctx, env, asset) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return asset, nil
 }
 
-func resourceReadAsset(ctx context.Context, d *schema.ResourceData, client ContentfulAssetClient) (diags diag.Diagnostics) {
-	spaceID := d.Get("space_id").(string)
+func resourceReadAsset(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulAssetClient) (diags diag.Diagnostics) {
 	assetID := d.Id()
 
-	asset, err := client.Get(ctx, spaceID, assetID)
+	asset, err := client.Get(ctx, env, assetID)
 	if _, ok := err.(contentful.NotFoundError); ok {
 		d.SetId("")
 		return nil
@@ -369,20 +680,32 @@ func resourceReadAsset(ctx context.Context, d *schema.ResourceData, client Conte
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
 	}
+
+	err = setAssetFields(d, asset)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
 	return
 }
 
-func resourceDeleteAsset(ctx context.Context, d *schema.ResourceData, client ContentfulAssetClient) (diags diag.Diagnostics) {
-	spaceID := d.Get("space_id").(string)
+func resourceDeleteAsset(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulAssetClient) (diags diag.Diagnostics) {
 	assetID := d.Id()
 
-	asset, err := client.Get(ctx, spaceID, assetID)
+	// Published and archived assets can't be deleted.
+	asset, err := changeAssetStatus(ctx, env, client, assetID, false, false)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		return nil
+	}
 	if err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
 	}
 
-	err = client.Delete(ctx, spaceID, asset)
+	err = client.Delete(ctx, env, asset)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		return nil
+	}
 	if err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
@@ -390,6 +713,50 @@ func resourceDeleteAsset(ctx context.Context, d *schema.ResourceData, client Con
 	return
 }
 
+func resourceImportAsset(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ids, err := parseImportID(d.Id(), "space_id", "env_id", "asset_id")
+	if err != nil {
+		// Assets of the master environment can be imported without env_id.
+		legacyIDs, legacyErr := parseImportID(d.Id(), "space_id", "asset_id")
+		if legacyErr != nil {
+			return nil, err
+		}
+		ids = []string{legacyIDs[0], defaultEnvironmentID, legacyIDs[1]}
+	}
+
+	client := m.(*providerClient)
+
+	env, err := client.Environments.Get(ctx, ids[0], ids[1])
+	if err != nil {
+		return nil, err
+	}
+
+	asset, err := client.assets.Get(ctx, env, ids[2])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("asset_id", asset.Sys.ID); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("env_id", ids[1]); err != nil {
+		return nil, err
+	}
+
+	if err := setAssetProperties(d, asset); err != nil {
+		return nil, err
+	}
+
+	if err := setAssetFields(d, asset); err != nil {
+		return nil, err
+	}
+
+	d.SetId(asset.Sys.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func setAssetProperties(d *schema.ResourceData, asset *contentful.Asset) (err error) {
 	if err = d.Set("space_id", asset.Sys.Space.Sys.ID); err != nil {
 		return err
@@ -399,5 +766,133 @@ func setAssetProperties(d *schema.ResourceData, asset *contentful.Asset) (err er
 		return err
 	}
 
+	status := publishingStatus(asset.Sys)
+	if err = d.Set("status", status); err != nil {
+		return err
+	}
+
+	if err = d.Set("published", status == publishingStatusPublished); err != nil {
+		return err
+	}
+
+	if err = d.Set("archived", status == publishingStatusArchived); err != nil {
+		return err
+	}
+
 	return err
 }
+
+// setAssetFields sets the url and details of the processed files. The
+// configured arguments of the files are kept, so that a file which was
+// uploaded doesn't show a diff. Without fields in the state, like after an
+// import, the fields are read from the asset.
+func setAssetFields(d *schema.ResourceData, asset *contentful.Asset) error {
+	if asset.Fields == nil {
+		return nil
+	}
+
+	current := map[string]interface{}{}
+	if fields, ok := d.Get("fields").([]interface{}); ok && len(fields) > 0 && fields[0] != nil {
+		current = fields[0].(map[string]interface{})
+	}
+
+	currentTitle, _ := current["title"].([]interface{})
+	currentDescription, _ := current["description"].([]interface{})
+	var currentFiles []interface{}
+	if files, ok := current["file"].(*schema.Set); ok {
+		currentFiles = files.List()
+	}
+
+	fields := map[string]interface{}{
+		"title":       flattenAssetLocalizedContent(asset.Fields.Title, currentTitle),
+		"description": flattenAssetLocalizedContent(asset.Fields.Description, currentDescription),
+		"file":        flattenAssetFiles(asset.Fields.File, currentFiles, d.Get("locale").(string)),
+	}
+
+	return d.Set("fields", []interface{}{fields})
+}
+
+// flattenAssetLocalizedContent converts the localized title or description of
+// an asset into a list. The locales of current keep their order, so that the
+// order of the configuration doesn't cause a diff, and other locales are added
+// at the end.
+func flattenAssetLocalizedContent(content map[string]string, current []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(content))
+	seen := map[string]bool{}
+	for _, raw := range current {
+		locale := raw.(map[string]interface{})["locale"].(string)
+		value, ok := content[locale]
+		if !ok || seen[locale] {
+			continue
+		}
+		seen[locale] = true
+
+		result = append(result, map[string]interface{}{
+			"content": value,
+			"locale":  locale,
+		})
+	}
+
+	for _, locale := range sortedLocales(content) {
+		if !seen[locale] {
+			result = append(result, map[string]interface{}{
+				"content": content[locale],
+				"locale":  locale,
+			})
+		}
+	}
+
+	return result
+}
+
+// flattenAssetFiles converts the files of an asset into the "file" set. The
+// files in currentFiles keep their arguments and get the url and details of
+// their locale. Files which are only in Contentful are added by locale.
+func flattenAssetFiles(files map[string]*contentful.File, currentFiles []interface{}, defaultLocale string) []interface{} {
+	result := make([]interface{}, 0, len(files))
+	seen := map[string]bool{}
+	for _, rawFile := range currentFiles {
+		current := rawFile.(map[string]interface{})
+
+		locale, _ := current["locale"].(string)
+		if locale == "" {
+			locale = defaultLocale
+		}
+		file, ok := files[locale]
+		if !ok {
+			continue
+		}
+		seen[locale] = true
+
+		flattened := map[string]interface{}{}
+		for k, v := range current {
+			flattened[k] = v
+		}
+		flattened["file_name"] = file.FileName
+		flattened["content_type"] = file.ContentType
+		flattened["url"] = file.URL
+		flattened["details"] = flattenFileDetails(file.Details)
+		result = append(result, flattened)
+	}
+
+	locales := make([]string, 0, len(files))
+	for locale := range files {
+		if !seen[locale] {
+			locales = append(locales, locale)
+		}
+	}
+	sort.Strings(locales)
+
+	for _, locale := range locales {
+		file := files[locale]
+		result = append(result, map[string]interface{}{
+			"locale":       locale,
+			"file_name":    file.FileName,
+			"content_type": file.ContentType,
+			"url":          file.URL,
+			"details":      flattenFileDetails(file.Details),
+		})
+	}
+
+	return result
+}
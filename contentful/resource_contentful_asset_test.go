@@ -0,0 +1,611 @@
+package contentful
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func init() {
+	// The fake server finishes processing on the next request, so there is
+	// no need to wait between polls.
+	assetProcessingPollInterval = time.Millisecond
+}
+
+// fakeAssetConfig returns the configuration of the unpublished asset "logo"
+// with the given files.
+func fakeAssetConfig(files ...interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"asset_id": "logo",
+		"space_id": "space",
+		"locale":   "en-US",
+		"fields": []interface{}{
+			map[string]interface{}{
+				"title":       []interface{}{map[string]interface{}{"locale": "en-US", "content": "Logo"}},
+				"description": []interface{}{map[string]interface{}{"locale": "en-US", "content": "The logo"}},
+				"file":        files,
+			},
+		},
+		"published": false,
+		"archived":  false,
+	}
+}
+
+func fakeAssetFiles(server *fakeServer, path string) map[string]interface{} {
+	return server.object(path)["fields"].(map[string]interface{})["file"].(map[string]interface{})
+}
+
+func TestResourceAssetLocalizedFiles_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+
+	path := "spaces/space/environments/master/assets/logo"
+	raw := fakeAssetConfig(
+		map[string]interface{}{
+			"upload":       "https://example.com/logo-en.png",
+			"file_name":    "logo-en.png",
+			"content_type": "image/png",
+		},
+		map[string]interface{}{
+			"locale":       "de",
+			"upload":       "https://example.com/logo-de.png",
+			"file_name":    "logo-de.png",
+			"content_type": "image/png",
+		},
+	)
+	raw["fields"].([]interface{})[0].(map[string]interface{})["title"] = []interface{}{
+		map[string]interface{}{"locale": "en-US", "content": "Logo"},
+		map[string]interface{}{"locale": "de", "content": "Logo DE"},
+	}
+	raw["published"] = true
+
+	r := resourceContentfulAsset()
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:   "create",
+			config: raw,
+			check: func(t *testing.T, d *schema.ResourceData) {
+				// The processed files are read into the state.
+				for _, rawFile := range d.Get("fields.0.file").(*schema.Set).List() {
+					file := rawFile.(map[string]interface{})
+					if file["url"] == "" || file["upload"] == "" {
+						t.Errorf("file should keep the upload and get the url: %v", file)
+					}
+					expectDetails := []interface{}{
+						map[string]interface{}{
+							"size":  1024,
+							"image": []interface{}{map[string]interface{}{"width": 640, "height": 480}},
+						},
+					}
+					if diff := cmp.Diff(expectDetails, file["details"]); diff != "" {
+						t.Errorf("details diff (-expect, +got)\n%s", diff)
+					}
+				}
+				if server.object(path)["sys"].(map[string]interface{})["publishedAt"] == nil {
+					t.Error("asset should be published after processing")
+				}
+			},
+		},
+		{
+			name: "read",
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if n := d.Get("fields.0.file").(*schema.Set).Len(); n != 2 {
+					t.Errorf("expected 2 files after reading the asset, got %d", n)
+				}
+
+				files := fakeAssetFiles(server, path)
+				for _, locale := range []string{"en-US", "de"} {
+					file, _ := files[locale].(map[string]interface{})
+					if file["url"] == nil || file["upload"] != nil {
+						t.Errorf("file of %s should be processed: %v", locale, file)
+					}
+					if file["fileName"] != "logo-"+locale[:2]+".png" {
+						t.Errorf("unexpected file of %s: %v", locale, file)
+					}
+				}
+			},
+		},
+		{name: "plan", config: raw, planOnly: true},
+	})
+
+	duplicate := fakeAssetConfig(
+		map[string]interface{}{"upload": "https://example.com/a.png", "file_name": "a.png", "content_type": "image/png"},
+		map[string]interface{}{"locale": "en-US", "upload": "https://example.com/b.png", "file_name": "b.png", "content_type": "image/png"},
+	)
+	duplicate["asset_id"] = "duplicate"
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:        "duplicated locale",
+			config:      duplicate,
+			expectError: regexp.MustCompile(`more than one file has the locale "en-US"`),
+		},
+	})
+}
+
+func TestResourceAssetLocalFiles_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+
+	sourcePath := filepath.Join(t.TempDir(), "logo.png")
+	if err := os.WriteFile(sourcePath, []byte("first"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	raw := fakeAssetConfig(
+		map[string]interface{}{
+			"source_path":  sourcePath,
+			"file_name":    "logo.png",
+			"content_type": "image/png",
+		},
+		map[string]interface{}{
+			"locale":         "de",
+			"content_base64": base64.StdEncoding.EncodeToString([]byte("german")),
+			"file_name":      "logo-de.png",
+			"content_type":   "image/png",
+		},
+	)
+	checkSizes := func(t *testing.T, expect map[string]int, uploads int) {
+		t.Helper()
+
+		files := fakeAssetFiles(server, "spaces/space/environments/master/assets/logo")
+		for locale, size := range expect {
+			file, _ := files[locale].(map[string]interface{})
+			details, _ := file["details"].(map[string]interface{})
+			if details["size"] != float64(size) || file["uploadFrom"] != nil {
+				t.Errorf("file of %s should be processed from an upload of %d bytes: %v", locale, size, file)
+			}
+		}
+		if len(server.uploads) != uploads {
+			t.Errorf("expected %d uploads, got %d", uploads, len(server.uploads))
+		}
+	}
+
+	testFakeResource(t, resourceContentfulAsset(), meta, []fakeResourceStep{
+		{
+			name:   "create",
+			config: raw,
+			check: func(t *testing.T, d *schema.ResourceData) {
+				checkSizes(t, map[string]int{"en-US": 5, "de": 6}, 2)
+				if got := d.Get("content_hashes.en-US"); got != "a7937b64b8caa58f03721bb6bacf5c78cb235febe0e70b1b84cd99541461a08e" {
+					t.Errorf("unexpected content hash %q", got)
+				}
+			},
+		},
+		// Unchanged files are neither planned nor uploaded again.
+		{name: "plan", config: raw, planOnly: true},
+		// Changing the file on disk uploads only that file again.
+		{
+			name: "change file",
+			remote: func(id string) {
+				if err := os.WriteFile(sourcePath, []byte("second!"), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			},
+			config: raw,
+			check: func(t *testing.T, d *schema.ResourceData) {
+				checkSizes(t, map[string]int{"en-US": 7, "de": 6}, 3)
+			},
+		},
+	})
+}
+
+func TestResourceAssetEnvironment_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+	createFakeEnvironment(t, meta, "space", "staging")
+
+	raw := func(envID string) map[string]interface{} {
+		raw := fakeAssetConfig(map[string]interface{}{"upload": "https://example.com/logo.png", "file_name": "logo.png", "content_type": "image/png"})
+		if envID != "" {
+			raw["env_id"] = envID
+		}
+		return raw
+	}
+
+	r := resourceContentfulAsset()
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:   "create in staging",
+			config: raw("staging"),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if server.object("spaces/space/environments/staging/assets/logo") == nil {
+					t.Error("asset should be created in staging")
+				}
+				if server.object("spaces/space/environments/master/assets/logo") != nil {
+					t.Error("asset shouldn't be created in master")
+				}
+			},
+		},
+	})
+
+	// Without env_id, assets are created in master.
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:   "create in master",
+			config: raw(""),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if d.Get("env_id") != "master" || server.object("spaces/space/environments/master/assets/logo") == nil {
+					t.Errorf("asset should be created in master, got env_id %q", d.Get("env_id"))
+				}
+			},
+		},
+	})
+
+	tests := map[string]struct {
+		id        string
+		expectEnv string
+	}{
+		"with environment":    {id: "space/staging/logo", expectEnv: "staging"},
+		"without environment": {id: "space/logo", expectEnv: "master"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testFakeResource(t, r, meta, []fakeResourceStep{
+				{
+					name:     "import",
+					importID: tt.id,
+					check: func(t *testing.T, d *schema.ResourceData) {
+						if d.Id() != "logo" || d.Get("env_id") != tt.expectEnv || d.Get("fields.0.title.0.content") != "Logo" {
+							t.Errorf("unexpected state: %v", d.State().Attributes)
+						}
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestResourceAssetPublishingState_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+
+	raw := func(upload string, published, archived bool) map[string]interface{} {
+		raw := fakeAssetConfig(map[string]interface{}{"upload": upload, "file_name": "logo.png", "content_type": "image/png"})
+		raw["published"] = published
+		raw["archived"] = archived
+		return raw
+	}
+
+	r := resourceContentfulAsset()
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:   "create",
+			config: raw("https://example.com/logo.png", true, false),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if got := d.Get("status"); got != publishingStatusPublished {
+					t.Errorf("expected status %q, got %q", publishingStatusPublished, got)
+				}
+			},
+		},
+		// Changes made in the web app aren't published, which shows up in the
+		// plan.
+		{
+			name: "read changes",
+			remote: func(id string) {
+				server.update("spaces/space/environments/master/assets/"+id, func(obj map[string]interface{}) {})
+			},
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if d.Get("status") != publishingStatusChanged || d.Get("published") != false {
+					t.Errorf("asset should have unpublished changes: %v", d.State().Attributes)
+				}
+			},
+		},
+		{name: "plan changes", config: raw("https://example.com/logo.png", true, false), planOnly: true, expectChanges: true},
+		// Published assets are unpublished before they are archived.
+		{
+			name:   "archive",
+			config: raw("https://example.com/logo.png", false, true),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if d.Get("status") != publishingStatusArchived || d.Get("published") != false {
+					t.Errorf("asset should be archived: %v", d.State().Attributes)
+				}
+			},
+		},
+	})
+
+	// A file without upload can't be processed, so the asset can't be published.
+	unprocessed := raw("", true, false)
+	unprocessed["asset_id"] = "unprocessed"
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:        "publish unprocessed",
+			config:      unprocessed,
+			expectError: regexp.MustCompile("Cannot publish an asset with unprocessed files"),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if d.Get("status") != "" {
+					t.Errorf("status shouldn't be set after a failed publish, got %q", d.Get("status"))
+				}
+			},
+		},
+	})
+}
+
+func TestResourceAssetDelete_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+
+	tests := map[string]struct {
+		published bool
+		archived  bool
+		removed   bool
+	}{
+		"draft":     {},
+		"published": {published: true},
+		"archived":  {archived: true},
+		"removed":   {published: true, removed: true},
+	}
+
+	r := resourceContentfulAsset()
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			id := "logo-" + name
+			path := "spaces/space/environments/master/assets/" + id
+			raw := fakeAssetConfig(map[string]interface{}{"upload": "https://example.com/logo.png", "file_name": "logo.png", "content_type": "image/png"})
+			raw["asset_id"] = id
+			raw["published"] = tt.published
+			raw["archived"] = tt.archived
+
+			testFakeResource(t, r, meta, []fakeResourceStep{
+				{name: "create", config: raw},
+				{
+					name: "destroy",
+					remote: func(id string) {
+						if tt.removed {
+							server.remove(path)
+						}
+					},
+					destroy: true,
+					check: func(t *testing.T, d *schema.ResourceData) {
+						if server.object(path) != nil {
+							t.Errorf("asset should be deleted: %v", server.object(path))
+						}
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestResourceAssetFileURL_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+
+	config := fakeAssetConfig(map[string]interface{}{
+		"upload":       "https://example.com/logo.png",
+		"file_name":    "logo.png",
+		"content_type": "image/png",
+	})
+
+	testFakeResource(t, resourceContentfulAsset(), meta, []fakeResourceStep{
+		// Configurations which set url and details are still valid.
+		{
+			name: "create with url",
+			config: fakeAssetConfig(map[string]interface{}{
+				"url":          "//images.example.com/logo.png",
+				"file_name":    "logo.png",
+				"content_type": "image/png",
+				"details": []interface{}{
+					map[string]interface{}{
+						"size":  1024,
+						"image": []interface{}{map[string]interface{}{"width": 640, "height": 480}},
+					},
+				},
+			}),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				file := fakeAssetFiles(server, "spaces/space/environments/master/assets/logo")["en-US"]
+				if url := file.(map[string]interface{})["url"]; url != "//images.example.com/logo.png" {
+					t.Errorf("configured url should be sent, got %v", url)
+				}
+			},
+		},
+		// The url and details of the processed file don't differ from the
+		// configured file.
+		{
+			name:   "upload",
+			config: config,
+			check: func(t *testing.T, d *schema.ResourceData) {
+				for k, v := range d.State().Attributes {
+					if strings.HasPrefix(k, "fields.0.file.") && strings.HasSuffix(k, ".url") && v == "" {
+						t.Errorf("processed file should have a url: %v", d.State().Attributes)
+					}
+				}
+			},
+		},
+		{name: "plan", config: config, planOnly: true},
+	})
+}
+
+func TestResourceAssetKeepFile_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+
+	path := "spaces/space/environments/master/assets/logo"
+	var processed map[string]interface{}
+
+	// The imported asset only knows the processed file, which is kept when
+	// the title is changed.
+	config := fakeAssetConfig(map[string]interface{}{
+		"locale":       "en-US",
+		"file_name":    "logo.png",
+		"content_type": "image/png",
+	})
+	config["fields"].([]interface{})[0].(map[string]interface{})["title"] = []interface{}{
+		map[string]interface{}{"locale": "en-US", "content": "New logo"},
+	}
+
+	r := resourceContentfulAsset()
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name: "create",
+			config: fakeAssetConfig(map[string]interface{}{
+				"upload":       "https://example.com/logo.png",
+				"file_name":    "logo.png",
+				"content_type": "image/png",
+			}),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				processed = fakeAssetFiles(server, path)["en-US"].(map[string]interface{})
+				if processed["url"] == nil || processed["details"] == nil {
+					t.Fatalf("file should be processed: %v", processed)
+				}
+			},
+		},
+	})
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{name: "import", importID: "space/logo"},
+		{
+			name:   "update title",
+			config: config,
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if diff := cmp.Diff(processed, fakeAssetFiles(server, path)["en-US"]); diff != "" {
+					t.Errorf("processed file should be kept (-expect, +got)\n%s", diff)
+				}
+				title := server.object(path)["fields"].(map[string]interface{})["title"]
+				if diff := cmp.Diff(map[string]interface{}{"en-US": "New logo"}, title); diff != "" {
+					t.Errorf("title diff (-expect, +got)\n%s", diff)
+				}
+			},
+		},
+	})
+}
+
+func TestResourceAssetRemoteFieldChanges_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+
+	path := "spaces/space/environments/master/assets/logo"
+
+	// The locales of the title are not sorted, which must not cause a diff.
+	config := fakeAssetConfig(map[string]interface{}{
+		"upload":       "https://example.com/logo.png",
+		"file_name":    "logo.png",
+		"content_type": "image/png",
+	})
+	config["fields"].([]interface{})[0].(map[string]interface{})["title"] = []interface{}{
+		map[string]interface{}{"locale": "en-US", "content": "Logo"},
+		map[string]interface{}{"locale": "de-DE", "content": "Logo"},
+	}
+
+	r := resourceContentfulAsset()
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{name: "create", config: config},
+		{name: "plan unchanged", config: config, planOnly: true},
+		{
+			name: "edit in web app",
+			remote: func(id string) {
+				server.update(path, func(obj map[string]interface{}) {
+					fields := obj["fields"].(map[string]interface{})
+					fields["title"].(map[string]interface{})["en-US"] = "Renamed"
+					fields["description"] = map[string]interface{}{"en-US": "Changed"}
+				})
+			},
+			check: func(t *testing.T, d *schema.ResourceData) {
+				checkFakeAttributes(t, d, map[string]interface{}{
+					"fields.0.title.0.locale":        "en-US",
+					"fields.0.title.0.content":       "Renamed",
+					"fields.0.title.1.locale":        "de-DE",
+					"fields.0.description.0.content": "Changed",
+				})
+			},
+		},
+		{name: "plan restore", config: config, planOnly: true, expectChanges: true},
+		{
+			name:   "restore",
+			config: config,
+			check: func(t *testing.T, d *schema.ResourceData) {
+				fields := server.object(path)["fields"].(map[string]interface{})
+				if diff := cmp.Diff(map[string]interface{}{"en-US": "Logo", "de-DE": "Logo"}, fields["title"]); diff != "" {
+					t.Errorf("title diff (-expect, +got)\n%s", diff)
+				}
+				if diff := cmp.Diff(map[string]interface{}{"en-US": "The logo"}, fields["description"]); diff != "" {
+					t.Errorf("description diff (-expect, +got)\n%s", diff)
+				}
+			},
+		},
+	})
+}
+
+func TestResourceAssetProcessChangedFiles_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+
+	raw := func(title, uploadDE string) map[string]interface{} {
+		raw := fakeAssetConfig(
+			map[string]interface{}{
+				"upload":       "https://example.com/logo-en.png",
+				"file_name":    "logo-en.png",
+				"content_type": "image/png",
+			},
+			map[string]interface{}{
+				"locale":       "de",
+				"upload":       uploadDE,
+				"file_name":    "logo-de.png",
+				"content_type": "image/png",
+			},
+		)
+		raw["fields"].([]interface{})[0].(map[string]interface{})["title"] = []interface{}{
+			map[string]interface{}{"locale": "en-US", "content": title},
+		}
+		raw["published"] = true
+		return raw
+	}
+
+	// from is the number of requests before the step.
+	var from int
+	startLog := func(id string) {
+		from = len(server.requestLog())
+	}
+	checkProcessed := func(expect ...string) func(t *testing.T, d *schema.ResourceData) {
+		return func(t *testing.T, d *schema.ResourceData) {
+			t.Helper()
+
+			var locales []string
+			for _, request := range server.requestLog()[from:] {
+				if strings.HasPrefix(request, "PUT ") && strings.HasSuffix(request, "/process") {
+					segments := strings.Split(request, "/")
+					locales = append(locales, segments[len(segments)-2])
+				}
+			}
+			if diff := cmp.Diff(expect, locales); diff != "" {
+				t.Errorf("processed locales diff (-expect, +got)\n%s", diff)
+			}
+		}
+	}
+
+	testFakeResource(t, resourceContentfulAsset(), meta, []fakeResourceStep{
+		// Every file is processed with the version of the asset after the
+		// previous one.
+		{
+			name:   "create",
+			remote: startLog,
+			config: raw("Logo", "https://example.com/logo-de.png"),
+			check:  checkProcessed("de", "en-US"),
+		},
+		// Files whose upload didn't change aren't processed again.
+		{
+			name:   "update title",
+			remote: startLog,
+			config: raw("New logo", "https://example.com/logo-de.png"),
+			check:  checkProcessed(),
+		},
+		{
+			name:   "update upload",
+			remote: startLog,
+			config: raw("New logo", "https://example.com/new-logo-de.png"),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				checkProcessed("de")(t, d)
+
+				file := fakeAssetFiles(server, "spaces/space/environments/master/assets/logo")["de"]
+				if url := file.(map[string]interface{})["url"]; url == nil {
+					t.Errorf("new file should be processed: %v", file)
+				}
+			},
+		},
+	})
+}
@@ -2,6 +2,7 @@ package contentful
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -15,6 +16,10 @@ func resourceContentfulContentType() *schema.Resource {
 		ReadContext:   wrapContentType(resourceContentTypeRead),
 		UpdateContext: wrapContentType(resourceContentTypeUpdate),
 		DeleteContext: wrapContentType(resourceContentTypeDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceContentTypeImport,
+		},
+		CustomizeDiff: validateFieldValidations,
 
 		Schema: map[string]*schema.Schema{
 			"space_id": {
@@ -87,6 +92,7 @@ func resourceContentfulContentType() *schema.Resource {
 										Optional: true,
 										Elem:     &schema.Schema{Type: schema.TypeString},
 									},
+									"validation": validationSchema(),
 								},
 							},
 						},
@@ -115,6 +121,7 @@ func resourceContentfulContentType() *schema.Resource {
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"validation": validationSchema(),
 					},
 				},
 			},
@@ -124,7 +131,7 @@ func resourceContentfulContentType() *schema.Resource {
 
 func wrapContentType(f func(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, apiKey ContentfulContentTypeClient) diag.Diagnostics) func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
-		client := m.(*contentful.Client)
+		client := m.(*providerClient)
 		spaceID := d.Get("space_id").(string)
 		envID := d.Get("env_id").(string)
 		env, err := client.Environments.Get(ctx, spaceID, envID)
@@ -158,7 +165,7 @@ func resourceContentTypeCreate(ctx context.Context, d *schema.ResourceData, env
 		ct.Description = description.(string)
 	}
 
-	ct.Fields, diags = newFields(d.Get("field").([]interface{}))
+	ct.Fields, diags = newFields(d.Get("field").([]interface{}), rawConfigAttr(d.GetRawConfig(), "field"))
 	if diags.HasError() {
 		return
 	}
@@ -179,11 +186,20 @@ func resourceContentTypeCreate(ctx context.Context, d *schema.ResourceData, env
 }
 
 func resourceContentTypeRead(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulContentTypeClient) (diags diag.Diagnostics) {
-	_, err := client.Get(ctx, env, d.Id())
+	ct, err := client.Get(ctx, env, d.Id())
+	if _, ok := err.(contentful.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
 	}
+
+	if err = setContentTypeProperties(d, ct); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
 	return
 }
 
@@ -230,7 +246,7 @@ func resourceContentTypeUpdate(ctx context.Context, d *schema.ResourceData, env
 		}
 	}
 
-	ct.Fields, diags = newFields(d.Get("field").([]interface{}))
+	ct.Fields, diags = newFields(d.Get("field").([]interface{}), rawConfigAttr(d.GetRawConfig(), "field"))
 	if diags.HasError() {
 		return
 	}
@@ -278,14 +294,213 @@ func resourceContentTypeDelete(ctx context.Context, d *schema.ResourceData, env
 	return
 }
 
+func resourceContentTypeImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ids, err := parseImportID(d.Id(), "space_id", "env_id", "content_type_id")
+	if err != nil {
+		return nil, err
+	}
+
+	client := m.(*providerClient)
+
+	env, err := client.Environments.Get(ctx, ids[0], ids[1])
+	if err != nil {
+		return nil, err
+	}
+
+	ct, err := client.ContentTypes.Get(ctx, env, ids[2])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("space_id", ids[0]); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("env_id", ids[1]); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("content_type_id", ct.Sys.ID); err != nil {
+		return nil, err
+	}
+
+	if err := setContentTypeProperties(d, ct); err != nil {
+		return nil, err
+	}
+
+	d.SetId(ct.Sys.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func setContentTypeProperties(d *schema.ResourceData, ct *contentful.ContentType) (err error) {
 	if err = d.Set("version", ct.Sys.Version); err != nil {
 		return err
 	}
 
+	if err = d.Set("name", ct.Name); err != nil {
+		return err
+	}
+
+	if err = d.Set("description", ct.Description); err != nil {
+		return err
+	}
+
+	if err = d.Set("display_field", ct.DisplayField); err != nil {
+		return err
+	}
+
+	fields, err := flattenFields(ct.Fields, d.Get("field").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if err = d.Set("field", fields); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// flattenFields converts the fields returned by the API into the "field" list.
+// currentFields is the list currently known to Terraform and is used to keep
+// the user's representation of values which are semantically unchanged.
+func flattenFields(fields []*contentful.Field, currentFields []interface{}) ([]interface{}, error) {
+	getFieldFromID := func(id string) map[string]interface{} {
+		for _, field := range currentFields {
+			castedField, ok := field.(map[string]interface{})
+			if ok && castedField["id"].(string) == id {
+				return castedField
+			}
+		}
+		return nil
+	}
+
+	result := make([]interface{}, 0, len(fields))
+	for _, field := range fields {
+		current := getFieldFromID(field.ID)
+
+		var currentItems []interface{}
+		if current != nil {
+			currentItems, _ = current["items"].([]interface{})
+		}
+
+		validations, validationBlocks, err := flattenFieldValidations(field.Validations, field.Type, current)
+		if err != nil {
+			return nil, err
+		}
+
+		items, err := flattenItems(field.Items, currentItems)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":          field.ID,
+			"name":        field.Name,
+			"type":        field.Type,
+			"link_type":   field.LinkType,
+			"items":       items,
+			"required":    field.Required,
+			"localized":   field.Localized,
+			"disabled":    field.Disabled,
+			"omitted":     field.Omitted,
+			"validations": validations,
+			"validation":  validationBlocks,
+		})
+	}
+	return result, nil
+}
+
+func flattenItems(items *contentful.FieldTypeArrayItem, currentItems []interface{}) ([]interface{}, error) {
+	if items == nil {
+		return []interface{}{}, nil
+	}
+
+	var current map[string]interface{}
+	if len(currentItems) > 0 {
+		current, _ = currentItems[0].(map[string]interface{})
+	}
+
+	validations, validationBlocks, err := flattenFieldValidations(items.Validations, items.Type, current)
+	if err != nil {
+		return nil, err
+	}
+
+	linkType := items.LinkType
+	// contentful-go decodes "linktype" instead of "linkType" for array items,
+	// so the link type is never returned. Keep the known value in that case.
+	if linkType == "" && current != nil {
+		linkType, _ = current["link_type"].(string)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":        items.Type,
+			"link_type":   linkType,
+			"validations": validations,
+			"validation":  validationBlocks,
+		},
+	}, nil
+}
+
+// flattenFieldValidations returns the validations in the representation the
+// user chose in current: "validation" blocks if present, JSON strings otherwise.
+// Validations without a block are returned as JSON strings next to the blocks.
+func flattenFieldValidations(validations []contentful.FieldValidation, fieldType string, current map[string]interface{}) (jsonValidations, validationBlocks []interface{}, err error) {
+	var currentValidations, currentBlocks []interface{}
+	if current != nil {
+		currentValidations, _ = current["validations"].([]interface{})
+		currentBlocks, _ = current["validation"].([]interface{})
+	}
+
+	if len(currentBlocks) > 0 {
+		var others []contentful.FieldValidation
+		validationBlocks, others, err = flattenValidationBlocks(validations, fieldType, currentBlocks)
+		if err != nil {
+			return nil, nil, err
+		}
+		jsonValidations, err = flattenValidations(others, currentValidations)
+		return jsonValidations, validationBlocks, err
+	}
+
+	jsonValidations, err = flattenValidations(validations, currentValidations)
+	return jsonValidations, []interface{}{}, err
+}
+
+// flattenValidations serializes the validations back to JSON strings.
+// When currentValidations describe the same validations, they are returned
+// unchanged so that formatting differences don't show up as a diff.
+func flattenValidations(validations []contentful.FieldValidation, currentValidations []interface{}) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(validations))
+	for _, validation := range validations {
+		b, err := json.Marshal(validation)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, string(b))
+	}
+
+	if len(currentValidations) > 0 {
+		parsed, err := contentful.ParseValidations(currentValidations)
+		if err == nil && len(parsed) == len(result) {
+			equal := true
+			for i, validation := range parsed {
+				b, err := json.Marshal(validation)
+				if err != nil || string(b) != result[i].(string) {
+					equal = false
+					break
+				}
+			}
+			if equal {
+				return currentValidations, nil
+			}
+		}
+	}
+
+	return result, nil
+}
+
 // Contentful API should omit the field.
 // And if user want to change field type, user should delete the field completely before user create new field type field.
 func checkFieldsToOmit(oldFields, newFields []interface{}) (firstApplyFields, secondApplyFields []*contentful.Field, shouldSecondApply bool) {
@@ -325,7 +540,7 @@ func checkFieldsToOmit(oldFields, newFields []interface{}) (firstApplyFields, se
 			}
 		}
 
-		field, _ := newField(oldFieldMap, i)
+		field, _ := newField(oldFieldMap, i, cty.NilVal)
 		if toOmitted {
 			field.Omitted = true
 		}
@@ -340,13 +555,15 @@ func checkFieldsToOmit(oldFields, newFields []interface{}) (firstApplyFields, se
 	return
 }
 
-func newFields(newFields []interface{}) ([]*contentful.Field, diag.Diagnostics) {
+// newFields converts the "field" list into the fields of the API. config is
+// the raw configuration of the list, see expandValidationBlocks.
+func newFields(newFields []interface{}, config cty.Value) ([]*contentful.Field, diag.Diagnostics) {
 	result := make([]*contentful.Field, len(newFields))
 	diags := make(diag.Diagnostics, 0)
 	for i := 0; i < len(newFields); i++ {
 		newFieldMap := newFields[i].(map[string]interface{})
 		var diag diag.Diagnostics
-		result[i], diag = newField(newFieldMap, i)
+		result[i], diag = newField(newFieldMap, i, rawConfigIndex(config, i))
 		if diag.HasError() {
 			diags = append(diags, diag...)
 		}
@@ -354,7 +571,7 @@ func newFields(newFields []interface{}) ([]*contentful.Field, diag.Diagnostics)
 	return result, diags
 }
 
-func newField(newField map[string]interface{}, i int) (*contentful.Field, diag.Diagnostics) {
+func newField(newField map[string]interface{}, i int, config cty.Value) (*contentful.Field, diag.Diagnostics) {
 	contentfulField := &contentful.Field{
 		ID:        newField["id"].(string),
 		Name:      newField["name"].(string),
@@ -389,13 +606,34 @@ func newField(newField map[string]interface{}, i int) (*contentful.Field, diag.D
 		contentfulField.Validations = parsedValidations
 	}
 
-	if items := processItems(newField["items"].([]interface{})); items != nil {
+	if blocks, ok := newField["validation"].([]interface{}); ok && len(blocks) > 0 {
+		validations, err := expandValidationBlocks(blocks, rawConfigAttr(config, "validation"), contentfulField.Type)
+		if err != nil {
+			return nil, diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "validation is invalid.",
+					Detail:   err.Error(),
+					AttributePath: cty.Path{
+						cty.GetAttrStep{Name: "field"},
+						cty.IndexStep{Key: cty.NumberIntVal(int64(i))},
+						cty.GetAttrStep{Name: "validation"},
+					},
+				},
+			}
+		}
+
+		// Validations without a block are kept in "validations".
+		contentfulField.Validations = append(validations, contentfulField.Validations...)
+	}
+
+	if items := processItems(newField["items"].([]interface{}), rawConfigAttr(config, "items")); items != nil {
 		contentfulField.Items = items
 	}
 	return contentfulField, nil
 }
 
-func processItems(fieldItems []interface{}) *contentful.FieldTypeArrayItem {
+func processItems(fieldItems []interface{}, config cty.Value) *contentful.FieldTypeArrayItem {
 	var items *contentful.FieldTypeArrayItem
 
 	for i := 0; i < len(fieldItems); i++ {
@@ -407,6 +645,11 @@ func processItems(fieldItems []interface{}) *contentful.FieldTypeArrayItem {
 			validations, _ = contentful.ParseValidations(fieldValidations)
 		}
 
+		if blocks, ok := item["validation"].([]interface{}); ok && len(blocks) > 0 {
+			blockValidations, _ := expandValidationBlocks(blocks, rawConfigAttr(rawConfigIndex(config, i), "validation"), item["type"].(string))
+			validations = append(blockValidations, validations...)
+		}
+
 		items = &contentful.FieldTypeArrayItem{
 			Type:        item["type"].(string),
 			Validations: validations,
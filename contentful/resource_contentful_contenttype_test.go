@@ -1,12 +1,15 @@
 package contentful
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	contentful "github.com/kitagry/contentful-go"
 )
 
@@ -65,11 +68,108 @@ func TestNewField(t *testing.T) {
 				},
 			},
 		},
+		"validation blocks": {
+			newField: map[string]interface{}{
+				"id":        "id",
+				"name":      "name",
+				"type":      "Integer",
+				"localized": false,
+				"required":  false,
+				"disabled":  false,
+				"omitted":   false,
+				"validation": []interface{}{
+					map[string]interface{}{
+						"range":   []interface{}{map[string]interface{}{"min": 0.0, "max": 10.5}},
+						"message": "too large",
+					},
+					map[string]interface{}{"in": []interface{}{"1", "2"}},
+					map[string]interface{}{"unique": true},
+					map[string]interface{}{
+						"asset_image_dimensions": []interface{}{
+							map[string]interface{}{
+								"width":  []interface{}{map[string]interface{}{"min": 10, "max": 100}},
+								"height": []interface{}{},
+							},
+						},
+					},
+					map[string]interface{}{"nodes": `{"entry-hyperlink":[{"linkContentType":["post"]}]}`},
+				},
+				"items": []interface{}(nil),
+			},
+			expectField: &contentful.Field{
+				ID:   "id",
+				Name: "name",
+				Type: "Integer",
+				Validations: []contentful.FieldValidation{
+					map[string]interface{}{"range": map[string]interface{}{"max": 10.5}, "message": "too large"},
+					map[string]interface{}{"in": []interface{}{1, 2}},
+					map[string]interface{}{"unique": true},
+					map[string]interface{}{"assetImageDimensions": map[string]interface{}{"width": map[string]interface{}{"min": 10, "max": 100}}},
+					map[string]interface{}{"nodes": map[string]interface{}{"entry-hyperlink": []interface{}{map[string]interface{}{"linkContentType": []interface{}{"post"}}}}},
+				},
+			},
+		},
+		"validation blocks next to validations without a block": {
+			newField: map[string]interface{}{
+				"id":          "id",
+				"name":        "name",
+				"type":        "Date",
+				"localized":   false,
+				"required":    false,
+				"disabled":    false,
+				"omitted":     false,
+				"validations": []interface{}{`{"dateRange":{"min":"2020-01-01T00:00:00"}}`},
+				"validation": []interface{}{
+					map[string]interface{}{"unique": true},
+				},
+				"items": []interface{}(nil),
+			},
+			expectField: &contentful.Field{
+				ID:   "id",
+				Name: "name",
+				Type: "Date",
+				Validations: []contentful.FieldValidation{
+					map[string]interface{}{"unique": true},
+					contentful.FieldValidationDate{Range: &contentful.DateMinMax{Min: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}},
+				},
+			},
+		},
+		"validation block with several kinds": {
+			newField: map[string]interface{}{
+				"id":        "id",
+				"name":      "name",
+				"type":      "Symbol",
+				"localized": false,
+				"required":  false,
+				"disabled":  false,
+				"omitted":   false,
+				"validation": []interface{}{
+					map[string]interface{}{
+						"unique": true,
+						"in":     []interface{}{"a"},
+					},
+				},
+				"items": []interface{}(nil),
+			},
+			i: 1,
+			expectDiags: diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "validation is invalid.",
+					Detail:   "validation 0: exactly one of size, range, regexp, in, link_content_type, link_mimetype_group, unique, asset_image_dimensions, asset_file_size, enabled_marks, enabled_node_types, nodes has to be set, got 2",
+					AttributePath: cty.Path{
+						cty.GetAttrStep{Name: "field"},
+						cty.IndexStep{Key: cty.NumberIntVal(1)},
+						cty.GetAttrStep{Name: "validation"},
+					},
+				},
+			},
+		},
 	}
 
 	for n, tt := range tests {
 		t.Run(n, func(t *testing.T) {
-			gotField, gotDiags := newField(tt.newField, tt.i)
+			gotField, gotDiags := newField(tt.newField, tt.i, cty.NilVal)
 			if diff := cmp.Diff(tt.expectField, gotField); diff != "" {
 				t.Errorf("gotField result diff (-expect, +got)\n%s", diff)
 			}
@@ -79,3 +179,535 @@ func TestNewField(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandValidationBlocks(t *testing.T) {
+	limits := func(min, max cty.Value) cty.Value {
+		return cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"min": min, "max": max})})
+	}
+
+	tests := map[string]struct {
+		blocks    []interface{}
+		config    cty.Value
+		fieldType string
+		expect    []contentful.FieldValidation
+	}{
+		"range with a min of 0": {
+			blocks: []interface{}{
+				map[string]interface{}{"range": []interface{}{map[string]interface{}{"min": 0.0, "max": 5.0}}},
+			},
+			config: cty.TupleVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{"range": limits(cty.NumberIntVal(0), cty.NumberIntVal(5))}),
+			}),
+			fieldType: "Number",
+			expect: []contentful.FieldValidation{
+				map[string]interface{}{"range": map[string]interface{}{"min": 0.0, "max": 5.0}},
+			},
+		},
+		"size with only a min of 0": {
+			blocks: []interface{}{
+				map[string]interface{}{"size": []interface{}{map[string]interface{}{"min": 0, "max": 0}}},
+			},
+			config: cty.TupleVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{"size": limits(cty.NumberIntVal(0), cty.NullVal(cty.Number))}),
+			}),
+			fieldType: "Symbol",
+			expect: []contentful.FieldValidation{
+				map[string]interface{}{"size": map[string]interface{}{"min": 0}},
+			},
+		},
+		"image width with a min of 0": {
+			blocks: []interface{}{
+				map[string]interface{}{"asset_image_dimensions": []interface{}{map[string]interface{}{
+					"width":  []interface{}{map[string]interface{}{"min": 0, "max": 100}},
+					"height": []interface{}{},
+				}}},
+			},
+			config: cty.TupleVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{"asset_image_dimensions": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"width":  limits(cty.NumberIntVal(0), cty.NumberIntVal(100)),
+					"height": cty.ListValEmpty(cty.Object(map[string]cty.Type{"min": cty.Number, "max": cty.Number})),
+				})})}),
+			}),
+			fieldType: "Link",
+			expect: []contentful.FieldValidation{
+				map[string]interface{}{"assetImageDimensions": map[string]interface{}{"width": map[string]interface{}{"min": 0, "max": 100}}},
+			},
+		},
+		// Limits of 0 from the state can't be told apart from unset ones.
+		"without configuration": {
+			blocks: []interface{}{
+				map[string]interface{}{"size": []interface{}{map[string]interface{}{"min": 0, "max": 3}}},
+			},
+			config:    cty.NilVal,
+			fieldType: "Symbol",
+			expect: []contentful.FieldValidation{
+				map[string]interface{}{"size": map[string]interface{}{"max": 3}},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := expandValidationBlocks(tt.blocks, tt.config, tt.fieldType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.expect, got); diff != "" {
+				t.Errorf("validations diff (-expect, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckValidationBlocks(t *testing.T) {
+	tests := map[string]struct {
+		field     map[string]interface{}
+		expectErr bool
+	}{
+		"validations without a block next to blocks": {
+			field: map[string]interface{}{
+				"validations": []interface{}{`{"dateRange":{"min":"2020-01-01T00:00:00"}}`},
+				"validation":  []interface{}{map[string]interface{}{"unique": true}},
+			},
+		},
+		"validations with a block next to blocks": {
+			field: map[string]interface{}{
+				"validations": []interface{}{`{"size":{"max":3}}`},
+				"validation":  []interface{}{map[string]interface{}{"unique": true}},
+			},
+			expectErr: true,
+		},
+		"only validations": {
+			field: map[string]interface{}{
+				"validations": []interface{}{`{"size":{"max":3}}`},
+			},
+		},
+		"block with several kinds": {
+			field: map[string]interface{}{
+				"validation": []interface{}{map[string]interface{}{"unique": true, "in": []interface{}{"a"}}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := checkValidationBlocks(tt.field, "field.0")
+			if tt.expectErr != (err != nil) {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestFlattenFields(t *testing.T) {
+	tests := map[string]struct {
+		fields        []*contentful.Field
+		currentFields []interface{}
+
+		expect []interface{}
+	}{
+		"new field": {
+			fields: []*contentful.Field{
+				{
+					ID:       "id",
+					Name:     "name",
+					Type:     "Symbol",
+					Required: true,
+					Validations: []contentful.FieldValidation{
+						contentful.FieldValidationUnique{Unique: true},
+					},
+				},
+			},
+			expect: []interface{}{
+				map[string]interface{}{
+					"id":          "id",
+					"name":        "name",
+					"type":        "Symbol",
+					"link_type":   "",
+					"items":       []interface{}{},
+					"required":    true,
+					"localized":   false,
+					"disabled":    false,
+					"omitted":     false,
+					"validations": []interface{}{`{"unique":true}`},
+					"validation":  []interface{}{},
+				},
+			},
+		},
+		"keep equivalent validations": {
+			fields: []*contentful.Field{
+				{
+					ID:   "id",
+					Name: "name",
+					Type: "Array",
+					Items: &contentful.FieldTypeArrayItem{
+						Type: "Link",
+						Validations: []contentful.FieldValidation{
+							contentful.FieldValidationLink{LinkContentType: []string{"a"}},
+						},
+					},
+					Validations: []contentful.FieldValidation{
+						contentful.FieldValidationSize{Size: &contentful.MinMax{Max: 3}},
+					},
+				},
+			},
+			currentFields: []interface{}{
+				map[string]interface{}{
+					"id": "id",
+					"items": []interface{}{
+						map[string]interface{}{
+							"type":        "Link",
+							"link_type":   "Entry",
+							"validations": []interface{}{`{ "linkContentType": ["a"] }`},
+						},
+					},
+					"validations": []interface{}{`{ "size": { "max": 3 } }`},
+				},
+			},
+			expect: []interface{}{
+				map[string]interface{}{
+					"id":        "id",
+					"name":      "name",
+					"type":      "Array",
+					"link_type": "",
+					"items": []interface{}{
+						map[string]interface{}{
+							"type":        "Link",
+							"link_type":   "Entry",
+							"validations": []interface{}{`{ "linkContentType": ["a"] }`},
+							"validation":  []interface{}{},
+						},
+					},
+					"required":    false,
+					"localized":   false,
+					"disabled":    false,
+					"omitted":     false,
+					"validations": []interface{}{`{ "size": { "max": 3 } }`},
+					"validation":  []interface{}{},
+				},
+			},
+		},
+		"changed validations": {
+			fields: []*contentful.Field{
+				{
+					ID:   "id",
+					Name: "name",
+					Type: "Symbol",
+					Validations: []contentful.FieldValidation{
+						contentful.FieldValidationSize{Size: &contentful.MinMax{Max: 5}},
+					},
+				},
+			},
+			currentFields: []interface{}{
+				map[string]interface{}{
+					"id":          "id",
+					"items":       []interface{}{},
+					"validations": []interface{}{`{ "size": { "max": 3 } }`},
+				},
+			},
+			expect: []interface{}{
+				map[string]interface{}{
+					"id":          "id",
+					"name":        "name",
+					"type":        "Symbol",
+					"link_type":   "",
+					"items":       []interface{}{},
+					"required":    false,
+					"localized":   false,
+					"disabled":    false,
+					"omitted":     false,
+					"validations": []interface{}{`{"size":{"max":5}}`},
+					"validation":  []interface{}{},
+				},
+			},
+		},
+		"keep equivalent validation blocks": {
+			fields: []*contentful.Field{
+				{
+					ID:   "id",
+					Name: "name",
+					Type: "RichText",
+					Validations: []contentful.FieldValidation{
+						contentful.FieldValidationEnabledMarks{EnabledMarks: []string{"bold"}, ErrorMessage: "only bold"},
+						contentful.FieldValidationSize{Size: &contentful.MinMax{Max: 3}},
+					},
+				},
+			},
+			currentFields: []interface{}{
+				map[string]interface{}{
+					"id":    "id",
+					"items": []interface{}{},
+					"validation": []interface{}{
+						map[string]interface{}{"enabled_marks": []interface{}{"bold"}, "message": "only bold"},
+						// contentful-go drops nodes validations when decoding.
+						map[string]interface{}{"nodes": `{"embedded-entry-block":[{"size":{"max":1}}]}`},
+						map[string]interface{}{"size": []interface{}{map[string]interface{}{"min": 0, "max": 3}}},
+					},
+				},
+			},
+			expect: []interface{}{
+				map[string]interface{}{
+					"id":          "id",
+					"name":        "name",
+					"type":        "RichText",
+					"link_type":   "",
+					"items":       []interface{}{},
+					"required":    false,
+					"localized":   false,
+					"disabled":    false,
+					"omitted":     false,
+					"validations": []interface{}{},
+					"validation": []interface{}{
+						map[string]interface{}{"enabled_marks": []interface{}{"bold"}, "message": "only bold"},
+						map[string]interface{}{"nodes": `{"embedded-entry-block":[{"size":{"max":1}}]}`},
+						map[string]interface{}{"size": []interface{}{map[string]interface{}{"min": 0, "max": 3}}},
+					},
+				},
+			},
+		},
+		"keep validations without a block": {
+			fields: []*contentful.Field{
+				{
+					ID:   "id",
+					Name: "name",
+					Type: "Date",
+					Validations: []contentful.FieldValidation{
+						contentful.FieldValidationDate{Range: &contentful.DateMinMax{Min: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}},
+						contentful.FieldValidationUnique{Unique: true},
+					},
+				},
+			},
+			currentFields: []interface{}{
+				map[string]interface{}{
+					"id":    "id",
+					"items": []interface{}{},
+					"validation": []interface{}{
+						map[string]interface{}{"unique": true},
+					},
+				},
+			},
+			expect: []interface{}{
+				map[string]interface{}{
+					"id":          "id",
+					"name":        "name",
+					"type":        "Date",
+					"link_type":   "",
+					"items":       []interface{}{},
+					"required":    false,
+					"localized":   false,
+					"disabled":    false,
+					"omitted":     false,
+					"validations": []interface{}{`{"dateRange":{"min":"2020-01-01T00:00:00Z","max":"0001-01-01T00:00:00Z"}}`},
+					"validation": []interface{}{
+						map[string]interface{}{"unique": true},
+					},
+				},
+			},
+		},
+		"changed validation blocks": {
+			fields: []*contentful.Field{
+				{
+					ID:   "id",
+					Name: "name",
+					Type: "Symbol",
+					Validations: []contentful.FieldValidation{
+						contentful.FieldValidationRegex{Regex: &contentful.Regex{Pattern: "^a", Flags: "i"}},
+						contentful.FieldValidationPredefinedValues{In: []interface{}{"a", "b"}},
+					},
+				},
+			},
+			currentFields: []interface{}{
+				map[string]interface{}{
+					"id":    "id",
+					"items": []interface{}{},
+					"validation": []interface{}{
+						map[string]interface{}{"in": []interface{}{"a"}},
+					},
+				},
+			},
+			expect: []interface{}{
+				map[string]interface{}{
+					"id":          "id",
+					"name":        "name",
+					"type":        "Symbol",
+					"link_type":   "",
+					"items":       []interface{}{},
+					"required":    false,
+					"localized":   false,
+					"disabled":    false,
+					"omitted":     false,
+					"validations": []interface{}{},
+					"validation": []interface{}{
+						map[string]interface{}{"regexp": []interface{}{map[string]interface{}{"pattern": "^a", "flags": "i"}}},
+						map[string]interface{}{"in": []interface{}{"a", "b"}},
+					},
+				},
+			},
+		},
+	}
+
+	for n, tt := range tests {
+		t.Run(n, func(t *testing.T) {
+			got, err := flattenFields(tt.fields, tt.currentFields)
+			if err != nil {
+				t.Fatalf("flattenFields returned error: %v", err)
+			}
+			if diff := cmp.Diff(tt.expect, got); diff != "" {
+				t.Errorf("flattenFields result diff (-expect, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceContentType_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+	ctx := context.Background()
+
+	r := resourceContentfulContentType()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"space_id":        "space",
+		"env_id":          "master",
+		"content_type_id": "post",
+		"name":            "Post",
+		"display_field":   "title",
+		"field": []interface{}{
+			map[string]interface{}{
+				"id":          "title",
+				"name":        "Title",
+				"type":        "Symbol",
+				"required":    true,
+				"validations": []interface{}{`{ "size": { "max": 10 } }`},
+			},
+		},
+	})
+	if diags := r.CreateContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("failed to create content type: %v", diags)
+	}
+	if got := d.Get("field.0.validations.0"); got != `{ "size": { "max": 10 } }` {
+		t.Errorf("equivalent validation should be kept as configured, got %v", got)
+	}
+
+	// The content type is changed in the web app.
+	path := "spaces/space/environments/master/content_types/post"
+	server.update(path, func(obj map[string]interface{}) {
+		obj["name"] = "Article"
+		field := obj["fields"].([]interface{})[0].(map[string]interface{})
+		field["name"] = "Headline"
+		field["validations"] = []interface{}{
+			map[string]interface{}{"size": map[string]interface{}{"max": 20}},
+		}
+	})
+
+	d = r.Data(d.State())
+	if diags := r.ReadContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("failed to read content type: %v", diags)
+	}
+	expect := map[string]interface{}{
+		"name":                  "Article",
+		"display_field":         "title",
+		"field.#":               1,
+		"field.0.name":          "Headline",
+		"field.0.type":          "Symbol",
+		"field.0.required":      true,
+		"field.0.validations.#": 1,
+		"field.0.validations.0": `{"size":{"max":20}}`,
+	}
+	for k, v := range expect {
+		if diff := cmp.Diff(v, d.Get(k)); diff != "" {
+			t.Errorf("%s diff (-expect, +got)\n%s", k, diff)
+		}
+	}
+
+	if diags := r.DeleteContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("failed to delete content type: %v", diags)
+	}
+
+	if diags := r.ReadContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("failed to read deleted content type: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("deleted content type should be removed from state, got id %q", d.Id())
+	}
+}
+
+func TestResourceContentTypeValidationBlocks_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+	ctx := context.Background()
+
+	r := resourceContentfulContentType()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"space_id":        "space",
+		"env_id":          "master",
+		"content_type_id": "post",
+		"name":            "Post",
+		"display_field":   "title",
+		"field": []interface{}{
+			map[string]interface{}{
+				"id":   "title",
+				"name": "Title",
+				"type": "Symbol",
+				"validation": []interface{}{
+					map[string]interface{}{
+						"size":    []interface{}{map[string]interface{}{"max": 10}},
+						"message": "too long",
+					},
+				},
+			},
+			map[string]interface{}{
+				"id":   "tags",
+				"name": "Tags",
+				"type": "Array",
+				"items": []interface{}{
+					map[string]interface{}{
+						"type": "Symbol",
+						"validation": []interface{}{
+							map[string]interface{}{"in": []interface{}{"news", "blog"}},
+						},
+					},
+				},
+			},
+		},
+	})
+	if diags := r.CreateContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("failed to create content type: %v", diags)
+	}
+
+	path := "spaces/space/environments/master/content_types/post"
+	fields := server.object(path)["fields"].([]interface{})
+	expectValidations := []interface{}{
+		map[string]interface{}{"size": map[string]interface{}{"max": float64(10)}, "message": "too long"},
+	}
+	if diff := cmp.Diff(expectValidations, fields[0].(map[string]interface{})["validations"]); diff != "" {
+		t.Errorf("sent validations diff (-expect, +got)\n%s", diff)
+	}
+
+	server.update(path, func(obj map[string]interface{}) {
+		field := obj["fields"].([]interface{})[0].(map[string]interface{})
+		field["validations"] = []interface{}{
+			map[string]interface{}{"size": map[string]interface{}{"max": 20}, "message": "too long"},
+		}
+	})
+
+	d = r.Data(d.State())
+	if diags := r.ReadContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("failed to read content type: %v", diags)
+	}
+	expect := map[string]interface{}{
+		"field.0.validations.#":               0,
+		"field.0.validation.#":                1,
+		"field.0.validation.0.size.0.max":     20,
+		"field.0.validation.0.message":        "too long",
+		"field.1.items.0.validation.#":        1,
+		"field.1.items.0.validation.0.in.#":   2,
+		"field.1.items.0.validation.0.in.1":   "blog",
+		"field.1.items.0.validation.0.unique": false,
+	}
+	for k, v := range expect {
+		if diff := cmp.Diff(v, d.Get(k)); diff != "" {
+			t.Errorf("%s diff (-expect, +got)\n%s", k, diff)
+		}
+	}
+}
@@ -0,0 +1,553 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	contentful "github.com/kitagry/contentful-go"
+)
+
+// validationKinds are the attributes of a "validation" block of which exactly
+// one has to be set.
+var validationKinds = []string{
+	"size",
+	"range",
+	"regexp",
+	"in",
+	"link_content_type",
+	"link_mimetype_group",
+	"unique",
+	"asset_image_dimensions",
+	"asset_file_size",
+	"enabled_marks",
+	"enabled_node_types",
+	"nodes",
+}
+
+var mimetypeGroups = []string{
+	contentful.MimeTypeAttachment,
+	contentful.MimeTypePlainText,
+	contentful.MimeTypeImage,
+	contentful.MimeTypeAudio,
+	contentful.MimeTypeVideo,
+	contentful.MimeTypeRichText,
+	contentful.MimeTypePresentation,
+	contentful.MimeTypeSpreadSheet,
+	contentful.MimeTypePDF,
+	contentful.MimeTypeArchive,
+	contentful.MimeTypeCode,
+	contentful.MimeTypeMarkup,
+}
+
+var richTextMarks = []string{
+	"bold",
+	"italic",
+	"underline",
+	"code",
+	"superscript",
+	"subscript",
+	"strikethrough",
+}
+
+var richTextNodeTypes = []string{
+	"heading-1",
+	"heading-2",
+	"heading-3",
+	"heading-4",
+	"heading-5",
+	"heading-6",
+	"ordered-list",
+	"unordered-list",
+	"hr",
+	"blockquote",
+	"table",
+	"embedded-entry-block",
+	"embedded-asset-block",
+	"embedded-resource-block",
+	"embedded-entry-inline",
+	"embedded-resource-inline",
+	"hyperlink",
+	"entry-hyperlink",
+	"asset-hyperlink",
+	"resource-hyperlink",
+}
+
+func minMaxSchema(t schema.ValueType) *schema.Schema {
+	limit := &schema.Schema{
+		Type:     t,
+		Optional: true,
+	}
+	if t == schema.TypeInt {
+		limit.ValidateDiagFunc = validation.ToDiagFunc(validation.IntAtLeast(0))
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"min": limit,
+				"max": limit,
+			},
+		},
+	}
+}
+
+func stringListSchema(valid []string) *schema.Schema {
+	elem := &schema.Schema{
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+	}
+	if valid != nil {
+		elem.ValidateDiagFunc = validation.ToDiagFunc(validation.StringInSlice(valid, false))
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     elem,
+	}
+}
+
+// validationSchema is the typed alternative to the "validations" JSON strings.
+// Every block describes one validation of the field.
+func validationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"size":  minMaxSchema(schema.TypeInt),
+				"range": minMaxSchema(schema.TypeFloat),
+				"regexp": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"pattern": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+							},
+							"flags": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile(`^[gimsuy]*$`), "flags may only contain g, i, m, s, u and y")),
+							},
+						},
+					},
+				},
+				"in":                  stringListSchema(nil),
+				"link_content_type":   stringListSchema(nil),
+				"link_mimetype_group": stringListSchema(mimetypeGroups),
+				"unique": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"asset_image_dimensions": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"width":  minMaxSchema(schema.TypeInt),
+							"height": minMaxSchema(schema.TypeInt),
+						},
+					},
+				},
+				"asset_file_size":    minMaxSchema(schema.TypeInt),
+				"enabled_marks":      stringListSchema(richTextMarks),
+				"enabled_node_types": stringListSchema(richTextNodeTypes),
+				"nodes": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsJSON),
+					DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+						return jsonEqual(oldValue, newValue)
+					},
+				},
+				"message": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// validateFieldValidations checks at plan time what the schema can't express:
+// every validation block sets exactly one kind of validation and next to
+// "validation" blocks, "validations" only holds validations without a block.
+func validateFieldValidations(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("field") {
+		return nil
+	}
+
+	for i, rawField := range d.Get("field").([]interface{}) {
+		field, ok := rawField.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if err := checkValidationBlocks(field, fmt.Sprintf("field.%d", i)); err != nil {
+			return err
+		}
+
+		items, _ := field["items"].([]interface{})
+		for _, rawItem := range items {
+			item, ok := rawItem.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if err := checkValidationBlocks(item, fmt.Sprintf("field.%d.items.0", i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func checkValidationBlocks(m map[string]interface{}, path string) error {
+	blocks, _ := m["validation"].([]interface{})
+	if validations, _ := m["validations"].([]interface{}); len(blocks) > 0 {
+		for j, validation := range validations {
+			parsed, err := contentful.ParseValidations([]interface{}{validation})
+			if err != nil || len(parsed) != 1 {
+				continue
+			}
+			if flattenValidationBlock(parsed[0]) != nil {
+				return fmt.Errorf("%s.validations.%d: use a validation block, validations can only hold validations without a block next to them", path, j)
+			}
+		}
+	}
+
+	for j, block := range blocks {
+		v, _ := block.(map[string]interface{})
+		if n := len(setValidationKinds(v)); n != 1 {
+			return fmt.Errorf("%s.validation.%d: exactly one of %s has to be set, got %d", path, j, strings.Join(validationKinds, ", "), n)
+		}
+	}
+	return nil
+}
+
+func setValidationKinds(v map[string]interface{}) []string {
+	var kinds []string
+	for _, kind := range validationKinds {
+		switch value := v[kind].(type) {
+		case []interface{}:
+			if len(value) > 0 {
+				kinds = append(kinds, kind)
+			}
+		case bool:
+			if value {
+				kinds = append(kinds, kind)
+			}
+		case string:
+			if value != "" {
+				kinds = append(kinds, kind)
+			}
+		}
+	}
+	return kinds
+}
+
+// expandValidationBlocks converts "validation" blocks into the JSON objects of
+// the API. fieldType is the type of the validated value and decides whether
+// the values of "in" are sent as numbers. config is the raw configuration of
+// the blocks, which tells limits of 0 apart from unset ones. It is null for
+// blocks which don't come from the configuration.
+func expandValidationBlocks(blocks []interface{}, config cty.Value, fieldType string) ([]contentful.FieldValidation, error) {
+	result := make([]contentful.FieldValidation, 0, len(blocks))
+	for i, block := range blocks {
+		v, _ := block.(map[string]interface{})
+		validation, err := expandValidationBlock(v, rawConfigIndex(config, i), fieldType)
+		if err != nil {
+			return nil, fmt.Errorf("validation %d: %w", i, err)
+		}
+		result = append(result, validation)
+	}
+	return result, nil
+}
+
+func expandValidationBlock(v map[string]interface{}, config cty.Value, fieldType string) (map[string]interface{}, error) {
+	kinds := setValidationKinds(v)
+	if len(kinds) != 1 {
+		return nil, fmt.Errorf("exactly one of %s has to be set, got %d", strings.Join(validationKinds, ", "), len(kinds))
+	}
+
+	result := map[string]interface{}{}
+	switch kinds[0] {
+	case "size":
+		result["size"] = expandMinMax(v["size"].([]interface{}), rawConfigAttr(config, "size"))
+	case "range":
+		result["range"] = expandMinMax(v["range"].([]interface{}), rawConfigAttr(config, "range"))
+	case "asset_file_size":
+		result["assetFileSize"] = expandMinMax(v["asset_file_size"].([]interface{}), rawConfigAttr(config, "asset_file_size"))
+	case "regexp":
+		regex := map[string]interface{}{}
+		if raw, ok := v["regexp"].([]interface{})[0].(map[string]interface{}); ok {
+			regex["pattern"] = raw["pattern"]
+			if flags, _ := raw["flags"].(string); flags != "" {
+				regex["flags"] = flags
+			}
+		}
+		result["regexp"] = regex
+	case "in":
+		in, err := expandPredefinedValues(v["in"].([]interface{}), fieldType)
+		if err != nil {
+			return nil, err
+		}
+		result["in"] = in
+	case "link_content_type":
+		result["linkContentType"] = v["link_content_type"]
+	case "link_mimetype_group":
+		result["linkMimetypeGroup"] = v["link_mimetype_group"]
+	case "unique":
+		result["unique"] = true
+	case "asset_image_dimensions":
+		dimensions := map[string]interface{}{}
+		if raw, ok := v["asset_image_dimensions"].([]interface{})[0].(map[string]interface{}); ok {
+			dimensionsConfig := rawConfigIndex(rawConfigAttr(config, "asset_image_dimensions"), 0)
+			for _, key := range []string{"width", "height"} {
+				if limits, _ := raw[key].([]interface{}); len(limits) > 0 {
+					dimensions[key] = expandMinMax(limits, rawConfigAttr(dimensionsConfig, key))
+				}
+			}
+		}
+		result["assetImageDimensions"] = dimensions
+	case "enabled_marks":
+		result["enabledMarks"] = v["enabled_marks"]
+	case "enabled_node_types":
+		result["enabledNodeTypes"] = v["enabled_node_types"]
+	case "nodes":
+		var nodes map[string]interface{}
+		if err := json.Unmarshal([]byte(v["nodes"].(string)), &nodes); err != nil {
+			return nil, fmt.Errorf("nodes has to be a JSON object: %w", err)
+		}
+		result["nodes"] = nodes
+	}
+
+	if message, _ := v["message"].(string); message != "" {
+		result["message"] = message
+	}
+
+	return result, nil
+}
+
+// expandMinMax returns the limits which are set in config, the raw
+// configuration of the limits block. Without config, limits of 0 can't be
+// told apart from unset ones and are omitted.
+func expandMinMax(raw []interface{}, config cty.Value) map[string]interface{} {
+	result := map[string]interface{}{}
+	if len(raw) == 0 {
+		return result
+	}
+
+	limits, ok := raw[0].(map[string]interface{})
+	if !ok {
+		return result
+	}
+
+	config = rawConfigIndex(config, 0)
+	for _, key := range []string{"min", "max"} {
+		value, ok := limits[key]
+		if !ok {
+			continue
+		}
+
+		set := value != 0 && value != 0.0
+		if !config.IsNull() {
+			set = !rawConfigAttr(config, key).IsNull()
+		}
+
+		if set {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// rawConfigAttr returns the attribute of an object of the raw configuration,
+// or a null value if the object isn't known.
+func rawConfigAttr(v cty.Value, name string) cty.Value {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() || !v.Type().HasAttribute(name) {
+		return cty.NilVal
+	}
+	return v.GetAttr(name)
+}
+
+// rawConfigIndex returns the element of a list of the raw configuration, or a
+// null value if the list isn't known.
+func rawConfigIndex(v cty.Value, i int) cty.Value {
+	if v.IsNull() || !v.IsKnown() || !(v.Type().IsListType() || v.Type().IsTupleType()) || v.LengthInt() <= i {
+		return cty.NilVal
+	}
+	return v.Index(cty.NumberIntVal(int64(i)))
+}
+
+func expandPredefinedValues(raw []interface{}, fieldType string) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(raw))
+	for _, value := range raw {
+		s, _ := value.(string)
+		switch fieldType {
+		case "Integer":
+			i, err := strconv.Atoi(s)
+			if err != nil {
+				return nil, fmt.Errorf("in: %q is not an integer", s)
+			}
+			result = append(result, i)
+		case "Number":
+			f, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return nil, fmt.Errorf("in: %q is not a number", s)
+			}
+			result = append(result, f)
+		default:
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+// flattenValidationBlocks converts the validations returned by the API into
+// "validation" blocks. Validations which can't be expressed with a block, e.g.
+// dateRange, are returned as others, so that they can be kept in
+// "validations". When currentBlocks describe the same validations, they are
+// returned unchanged. This also keeps validations which contentful-go drops
+// when decoding, e.g. nodes.
+func flattenValidationBlocks(validations []contentful.FieldValidation, fieldType string, currentBlocks []interface{}) (blocks []interface{}, others []contentful.FieldValidation, err error) {
+	var supported []contentful.FieldValidation
+	blocks = make([]interface{}, 0, len(validations))
+	for _, validation := range validations {
+		block := flattenValidationBlock(validation)
+		if block == nil {
+			others = append(others, validation)
+			continue
+		}
+		supported = append(supported, validation)
+		blocks = append(blocks, block)
+	}
+
+	if len(currentBlocks) > 0 {
+		expanded, err := expandValidationBlocks(currentBlocks, cty.NilVal, fieldType)
+		if err == nil && equalValidations(supported, expanded) {
+			return currentBlocks, others, nil
+		}
+	}
+
+	return blocks, others, nil
+}
+
+// equalValidations compares the validations returned by contentful-go with
+// the expanded ones after passing them through the same decoding.
+func equalValidations(validations, expanded []contentful.FieldValidation) bool {
+	raw := make([]interface{}, len(expanded))
+	for i, v := range expanded {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return false
+		}
+		raw[i] = string(b)
+	}
+
+	parsed, err := contentful.ParseValidations(raw)
+	if err != nil {
+		return false
+	}
+
+	a, err := json.Marshal(parsed)
+	if err != nil {
+		return false
+	}
+	b, err := json.Marshal(validations)
+	if err != nil {
+		return false
+	}
+	return string(a) == string(b)
+}
+
+func flattenValidationBlock(validation contentful.FieldValidation) map[string]interface{} {
+	switch v := validation.(type) {
+	case contentful.FieldValidationSize:
+		return withMessage(map[string]interface{}{"size": flattenMinMax(v.Size, false)}, v.ErrorMessage)
+	case contentful.FieldValidationRange:
+		return withMessage(map[string]interface{}{"range": flattenMinMax(v.Range, true)}, v.ErrorMessage)
+	case contentful.FieldValidationFileSize:
+		return withMessage(map[string]interface{}{"asset_file_size": flattenMinMax(v.Size, false)}, v.ErrorMessage)
+	case contentful.FieldValidationRegex:
+		regex := map[string]interface{}{}
+		if v.Regex != nil {
+			regex["pattern"] = v.Regex.Pattern
+			regex["flags"] = v.Regex.Flags
+		}
+		return withMessage(map[string]interface{}{"regexp": []interface{}{regex}}, v.ErrorMessage)
+	case contentful.FieldValidationPredefinedValues:
+		in := make([]interface{}, 0, len(v.In))
+		for _, value := range v.In {
+			switch value := value.(type) {
+			case float64:
+				in = append(in, strconv.FormatFloat(value, 'f', -1, 64))
+			default:
+				in = append(in, fmt.Sprint(value))
+			}
+		}
+		return withMessage(map[string]interface{}{"in": in}, v.ErrorMessage)
+	case contentful.FieldValidationLink:
+		return map[string]interface{}{"link_content_type": stringsToInterfaces(v.LinkContentType)}
+	case contentful.FieldValidationMimeType:
+		return map[string]interface{}{"link_mimetype_group": stringsToInterfaces(v.MimeTypes)}
+	case contentful.FieldValidationUnique:
+		return map[string]interface{}{"unique": v.Unique}
+	case contentful.FieldValidationDimension:
+		return withMessage(map[string]interface{}{
+			"asset_image_dimensions": []interface{}{
+				map[string]interface{}{
+					"width":  flattenMinMax(v.Width, false),
+					"height": flattenMinMax(v.Height, false),
+				},
+			},
+		}, v.ErrorMessage)
+	case contentful.FieldValidationEnabledMarks:
+		return withMessage(map[string]interface{}{"enabled_marks": stringsToInterfaces(v.EnabledMarks)}, v.ErrorMessage)
+	case contentful.FieldValidationEnabledNodeTypes:
+		return withMessage(map[string]interface{}{"enabled_node_types": stringsToInterfaces(v.EnabledNodeTypes)}, v.ErrorMessage)
+	}
+
+	// The validation can't be expressed with a block, e.g. dateRange.
+	return nil
+}
+
+func flattenMinMax(limits *contentful.MinMax, float bool) []interface{} {
+	if limits == nil {
+		return []interface{}{}
+	}
+
+	if float {
+		return []interface{}{map[string]interface{}{"min": limits.Min, "max": limits.Max}}
+	}
+	return []interface{}{map[string]interface{}{"min": int(limits.Min), "max": int(limits.Max)}}
+}
+
+func withMessage(block map[string]interface{}, message string) map[string]interface{} {
+	if message != "" {
+		block["message"] = message
+	}
+	return block
+}
+
+func stringsToInterfaces(s []string) []interface{} {
+	result := make([]interface{}, len(s))
+	for i, v := range s {
+		result[i] = v
+	}
+	return result
+}
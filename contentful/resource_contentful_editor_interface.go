@@ -0,0 +1,611 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	contentful "github.com/kitagry/contentful-go"
+)
+
+func resourceContentfulEditorInterface() *schema.Resource {
+	settingsSchema := &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsJSON),
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return jsonEqual(old, new)
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: wrapEditorInterface(resourceEditorInterfaceCreate),
+		ReadContext:   wrapEditorInterface(resourceEditorInterfaceRead),
+		UpdateContext: wrapEditorInterface(resourceEditorInterfaceUpdate),
+		// Editor interfaces can't be deleted, they go away with their content type.
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEditorInterfaceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"content_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"controls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"widget_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"widget_namespace": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "builtin",
+						},
+						"help_text": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"settings": settingsSchema,
+					},
+				},
+			},
+			"sidebar": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"widget_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"widget_namespace": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "sidebar-builtin",
+						},
+						"disabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"settings": settingsSchema,
+					},
+				},
+			},
+			"editor_layout": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"item": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"group_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"field_ids": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func wrapEditorInterface(f func(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulEditorInterfaceClient) diag.Diagnostics) func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+		client := m.(*providerClient)
+		spaceID := d.Get("space_id").(string)
+		envID := d.Get("env_id").(string)
+		env, err := client.Environments.Get(ctx, spaceID, envID)
+		if err != nil {
+			diags = append(diags, contentfulErrorToDiagnostic(err)...)
+			return
+		}
+		return f(ctx, d, env, client.editorInterfaces)
+	}
+}
+
+func resourceEditorInterfaceCreate(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulEditorInterfaceClient) (diags diag.Diagnostics) {
+	diags = updateEditorInterface(ctx, d, env, client)
+	if diags.HasError() {
+		return
+	}
+
+	d.SetId(d.Get("content_type_id").(string))
+
+	return
+}
+
+func resourceEditorInterfaceRead(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulEditorInterfaceClient) (diags diag.Diagnostics) {
+	ei, err := client.Get(ctx, env, d.Id())
+	if _, ok := err.(contentful.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	if err := setEditorInterfaceProperties(d, ei); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	return
+}
+
+func resourceEditorInterfaceUpdate(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulEditorInterfaceClient) (diags diag.Diagnostics) {
+	defer func() {
+		if diags.HasError() {
+			d.Partial(true)
+		}
+	}()
+
+	return updateEditorInterface(ctx, d, env, client)
+}
+
+// updateEditorInterface replaces the editor interface of the content type with
+// the configuration. The editor interface always exists, so creating the
+// resource updates it as well.
+func updateEditorInterface(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulEditorInterfaceClient) (diags diag.Diagnostics) {
+	contentTypeID := d.Get("content_type_id").(string)
+
+	ei, err := client.Get(ctx, env, contentTypeID)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	ei.Controls, diags = expandEditorInterfaceControls(d.Get("controls").([]interface{}))
+	if diags.HasError() {
+		return
+	}
+
+	ei.Sidebar, diags = expandEditorInterfaceSidebar(d.Get("sidebar").([]interface{}))
+	if diags.HasError() {
+		return
+	}
+
+	ei.EditorLayout, ei.GroupControls, diags = expandEditorLayout(d.Get("editor_layout").([]interface{}))
+	if diags.HasError() {
+		return
+	}
+
+	if err := client.Update(ctx, env, contentTypeID, ei); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	if err := setEditorInterfaceProperties(d, ei); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	return
+}
+
+func resourceEditorInterfaceImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ids, err := parseImportID(d.Id(), "space_id", "env_id", "content_type_id")
+	if err != nil {
+		return nil, err
+	}
+
+	client := m.(*providerClient)
+
+	env, err := client.Environments.Get(ctx, ids[0], ids[1])
+	if err != nil {
+		return nil, err
+	}
+
+	ei, err := client.editorInterfaces.Get(ctx, env, ids[2])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("space_id", ids[0]); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("env_id", ids[1]); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("content_type_id", ids[2]); err != nil {
+		return nil, err
+	}
+
+	// Import all controls, Read only refreshes the configured ones.
+	controls, err := flattenEditorInterfaceControls(ei.Controls, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("controls", controls); err != nil {
+		return nil, err
+	}
+
+	if err := setEditorInterfaceProperties(d, ei); err != nil {
+		return nil, err
+	}
+
+	d.SetId(ids[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setEditorInterfaceProperties(d *schema.ResourceData, ei *EditorInterface) error {
+	if err := d.Set("version", ei.Sys.Version); err != nil {
+		return err
+	}
+
+	controls, err := flattenEditorInterfaceControls(ei.Controls, d.Get("controls").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("controls", controls); err != nil {
+		return err
+	}
+
+	sidebar, err := flattenEditorInterfaceSidebar(ei.Sidebar, d.Get("sidebar").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("sidebar", sidebar); err != nil {
+		return err
+	}
+
+	if err := d.Set("editor_layout", flattenEditorLayout(ei.EditorLayout)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func expandEditorInterfaceControls(rawControls []interface{}) ([]EditorInterfaceControl, diag.Diagnostics) {
+	controls := make([]EditorInterfaceControl, 0, len(rawControls))
+	for i, raw := range rawControls {
+		control := raw.(map[string]interface{})
+
+		settings, err := expandSettings(control["settings"].(string))
+		if err != nil {
+			return nil, settingsDiagnostics(err, "controls", i)
+		}
+		if helpText := control["help_text"].(string); helpText != "" {
+			if settings == nil {
+				settings = map[string]interface{}{}
+			}
+			settings["helpText"] = helpText
+		}
+
+		controls = append(controls, EditorInterfaceControl{
+			FieldID:         control["field_id"].(string),
+			WidgetID:        control["widget_id"].(string),
+			WidgetNamespace: control["widget_namespace"].(string),
+			Settings:        settings,
+		})
+	}
+	return controls, nil
+}
+
+// flattenEditorInterfaceControls converts the controls into the "controls" list.
+// Contentful returns a control for every field of the content type, so only
+// the fields in currentControls are kept, in their current order. All controls
+// are returned when currentControls is nil.
+func flattenEditorInterfaceControls(controls []EditorInterfaceControl, currentControls []interface{}) ([]interface{}, error) {
+	if currentControls == nil {
+		result := make([]interface{}, 0, len(controls))
+		for _, control := range controls {
+			flattened, err := flattenEditorInterfaceControl(control, nil)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, flattened)
+		}
+		return result, nil
+	}
+
+	result := make([]interface{}, 0, len(currentControls))
+	for _, raw := range currentControls {
+		current, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, control := range controls {
+			if control.FieldID != current["field_id"] {
+				continue
+			}
+
+			flattened, err := flattenEditorInterfaceControl(control, current)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, flattened)
+			break
+		}
+	}
+	return result, nil
+}
+
+func flattenEditorInterfaceControl(control EditorInterfaceControl, current map[string]interface{}) (map[string]interface{}, error) {
+	settings := map[string]interface{}{}
+	for k, v := range control.Settings {
+		settings[k] = v
+	}
+
+	helpText, _ := settings["helpText"].(string)
+	delete(settings, "helpText")
+
+	currentSettings, _ := current["settings"].(string)
+	flattenedSettings, err := flattenSettings(settings, currentSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"field_id":         control.FieldID,
+		"widget_id":        control.WidgetID,
+		"widget_namespace": control.WidgetNamespace,
+		"help_text":        helpText,
+		"settings":         flattenedSettings,
+	}, nil
+}
+
+func expandEditorInterfaceSidebar(rawSidebar []interface{}) ([]EditorInterfaceSidebarItem, diag.Diagnostics) {
+	if len(rawSidebar) == 0 {
+		// Without a sidebar Contentful shows the default one.
+		return nil, nil
+	}
+
+	sidebar := make([]EditorInterfaceSidebarItem, 0, len(rawSidebar))
+	for i, raw := range rawSidebar {
+		item := raw.(map[string]interface{})
+
+		settings, err := expandSettings(item["settings"].(string))
+		if err != nil {
+			return nil, settingsDiagnostics(err, "sidebar", i)
+		}
+
+		sidebar = append(sidebar, EditorInterfaceSidebarItem{
+			WidgetID:        item["widget_id"].(string),
+			WidgetNamespace: item["widget_namespace"].(string),
+			Disabled:        item["disabled"].(bool),
+			Settings:        settings,
+		})
+	}
+	return sidebar, nil
+}
+
+func flattenEditorInterfaceSidebar(sidebar []EditorInterfaceSidebarItem, currentSidebar []interface{}) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(sidebar))
+	for i, item := range sidebar {
+		var currentSettings string
+		if i < len(currentSidebar) {
+			if current, ok := currentSidebar[i].(map[string]interface{}); ok {
+				currentSettings, _ = current["settings"].(string)
+			}
+		}
+
+		settings, err := flattenSettings(item.Settings, currentSettings)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, map[string]interface{}{
+			"widget_id":        item.WidgetID,
+			"widget_namespace": item.WidgetNamespace,
+			"disabled":         item.Disabled,
+			"settings":         settings,
+		})
+	}
+	return result, nil
+}
+
+// expandEditorLayout converts the "editor_layout" list into the editor layout
+// and the group controls which Contentful requires for every group. Top level
+// groups are shown as tabs and nested groups as field sets.
+func expandEditorLayout(rawLayout []interface{}) ([]EditorLayoutItem, []EditorInterfaceGroupControl, diag.Diagnostics) {
+	if len(rawLayout) == 0 {
+		return nil, nil, nil
+	}
+
+	layout := make([]EditorLayoutItem, 0, len(rawLayout))
+	groupControls := make([]EditorInterfaceGroupControl, 0, len(rawLayout))
+	for i, raw := range rawLayout {
+		group := raw.(map[string]interface{})
+
+		rawItems := group["item"].([]interface{})
+		items := make([]EditorLayoutItem, 0, len(rawItems))
+		for j, rawItem := range rawItems {
+			item := rawItem.(map[string]interface{})
+			fieldID := item["field_id"].(string)
+			groupID := item["group_id"].(string)
+
+			if (fieldID == "") == (groupID == "") {
+				return nil, nil, diag.Diagnostics{
+					{
+						Severity: diag.Error,
+						Summary:  "editor layout item must have either field_id or group_id",
+						AttributePath: cty.Path{
+							cty.GetAttrStep{Name: "editor_layout"},
+							cty.IndexStep{Key: cty.NumberIntVal(int64(i))},
+							cty.GetAttrStep{Name: "item"},
+							cty.IndexStep{Key: cty.NumberIntVal(int64(j))},
+						},
+					},
+				}
+			}
+
+			if fieldID != "" {
+				items = append(items, EditorLayoutItem{FieldID: fieldID})
+				continue
+			}
+
+			fieldSet := EditorLayoutItem{
+				GroupID: groupID,
+				Name:    item["name"].(string),
+				Items:   []EditorLayoutItem{},
+			}
+			for _, id := range item["field_ids"].([]interface{}) {
+				fieldSet.Items = append(fieldSet.Items, EditorLayoutItem{FieldID: id.(string)})
+			}
+			items = append(items, fieldSet)
+			groupControls = append(groupControls, EditorInterfaceGroupControl{
+				GroupID:         groupID,
+				WidgetNamespace: "builtin",
+				WidgetID:        "fieldset",
+			})
+		}
+
+		layout = append(layout, EditorLayoutItem{
+			GroupID: group["group_id"].(string),
+			Name:    group["name"].(string),
+			Items:   items,
+		})
+		groupControls = append(groupControls, EditorInterfaceGroupControl{
+			GroupID:         group["group_id"].(string),
+			WidgetNamespace: "builtin",
+			WidgetID:        "topLevelTab",
+		})
+	}
+	return layout, groupControls, nil
+}
+
+func flattenEditorLayout(layout []EditorLayoutItem) []interface{} {
+	result := make([]interface{}, 0, len(layout))
+	for _, group := range layout {
+		items := make([]interface{}, 0, len(group.Items))
+		for _, item := range group.Items {
+			if item.GroupID == "" {
+				items = append(items, map[string]interface{}{
+					"field_id":  item.FieldID,
+					"group_id":  "",
+					"name":      "",
+					"field_ids": []interface{}{},
+				})
+				continue
+			}
+
+			fieldIDs := make([]interface{}, 0, len(item.Items))
+			for _, field := range item.Items {
+				fieldIDs = append(fieldIDs, field.FieldID)
+			}
+			items = append(items, map[string]interface{}{
+				"field_id":  "",
+				"group_id":  item.GroupID,
+				"name":      item.Name,
+				"field_ids": fieldIDs,
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"group_id": group.GroupID,
+			"name":     group.Name,
+			"item":     items,
+		})
+	}
+	return result
+}
+
+// expandSettings decodes the JSON encoded settings of a widget.
+func expandSettings(settings string) (map[string]interface{}, error) {
+	if settings == "" {
+		return nil, nil
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal([]byte(settings), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// flattenSettings encodes the settings of a widget as JSON. The current value
+// is kept when it is semantically equal, so formatting doesn't cause a diff.
+func flattenSettings(settings map[string]interface{}, currentSettings string) (string, error) {
+	if len(settings) == 0 {
+		return "", nil
+	}
+
+	b, err := json.Marshal(settings)
+	if err != nil {
+		return "", err
+	}
+
+	if jsonEqual(currentSettings, string(b)) {
+		return currentSettings, nil
+	}
+	return string(b), nil
+}
+
+func settingsDiagnostics(err error, block string, i int) diag.Diagnostics {
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  "settings format is invalid.",
+			Detail:   err.Error(),
+			AttributePath: cty.Path{
+				cty.GetAttrStep{Name: block},
+				cty.IndexStep{Key: cty.NumberIntVal(int64(i))},
+				cty.GetAttrStep{Name: "settings"},
+			},
+		},
+	}
+}
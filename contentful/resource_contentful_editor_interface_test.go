@@ -0,0 +1,178 @@
+package contentful
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFlattenEditorInterfaceControls(t *testing.T) {
+	controls := []EditorInterfaceControl{
+		{FieldID: "title", WidgetID: "singleLine", WidgetNamespace: "builtin", Settings: map[string]interface{}{"helpText": "help"}},
+		{FieldID: "slug", WidgetID: "slugEditor", WidgetNamespace: "builtin", Settings: map[string]interface{}{"trackingFieldId": "title"}},
+		{FieldID: "body", WidgetID: "markdown", WidgetNamespace: "builtin"},
+	}
+
+	tests := map[string]struct {
+		current []interface{}
+		expect  []interface{}
+	}{
+		"all controls are returned without current controls": {
+			current: nil,
+			expect: []interface{}{
+				map[string]interface{}{"field_id": "title", "widget_id": "singleLine", "widget_namespace": "builtin", "help_text": "help", "settings": ""},
+				map[string]interface{}{"field_id": "slug", "widget_id": "slugEditor", "widget_namespace": "builtin", "help_text": "", "settings": `{"trackingFieldId":"title"}`},
+				map[string]interface{}{"field_id": "body", "widget_id": "markdown", "widget_namespace": "builtin", "help_text": "", "settings": ""},
+			},
+		},
+		"only current controls are returned in their order": {
+			current: []interface{}{
+				map[string]interface{}{"field_id": "slug", "settings": `{ "trackingFieldId": "title" }`},
+				map[string]interface{}{"field_id": "title"},
+			},
+			expect: []interface{}{
+				map[string]interface{}{"field_id": "slug", "widget_id": "slugEditor", "widget_namespace": "builtin", "help_text": "", "settings": `{ "trackingFieldId": "title" }`},
+				map[string]interface{}{"field_id": "title", "widget_id": "singleLine", "widget_namespace": "builtin", "help_text": "help", "settings": ""},
+			},
+		},
+		"removed controls are dropped": {
+			current: []interface{}{
+				map[string]interface{}{"field_id": "removed"},
+			},
+			expect: []interface{}{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := flattenEditorInterfaceControls(controls, tt.current)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.expect, got); diff != "" {
+				t.Errorf("flattenEditorInterfaceControls result diff (-expect, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandEditorLayout(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"group_id": "content",
+			"name":     "Content",
+			"item": []interface{}{
+				map[string]interface{}{"field_id": "title", "group_id": "", "name": "", "field_ids": []interface{}{}},
+				map[string]interface{}{"field_id": "", "group_id": "seo", "name": "SEO", "field_ids": []interface{}{"slug", "description"}},
+			},
+		},
+	}
+
+	layout, groupControls, diags := expandEditorLayout(raw)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expectLayout := []EditorLayoutItem{
+		{
+			GroupID: "content",
+			Name:    "Content",
+			Items: []EditorLayoutItem{
+				{FieldID: "title"},
+				{GroupID: "seo", Name: "SEO", Items: []EditorLayoutItem{{FieldID: "slug"}, {FieldID: "description"}}},
+			},
+		},
+	}
+	if diff := cmp.Diff(expectLayout, layout); diff != "" {
+		t.Errorf("layout diff (-expect, +got)\n%s", diff)
+	}
+
+	expectGroupControls := []EditorInterfaceGroupControl{
+		{GroupID: "seo", WidgetNamespace: "builtin", WidgetID: "fieldset"},
+		{GroupID: "content", WidgetNamespace: "builtin", WidgetID: "topLevelTab"},
+	}
+	if diff := cmp.Diff(expectGroupControls, groupControls); diff != "" {
+		t.Errorf("group controls diff (-expect, +got)\n%s", diff)
+	}
+
+	if diff := cmp.Diff(raw, flattenEditorLayout(layout)); diff != "" {
+		t.Errorf("flattenEditorLayout should round trip (-expect, +got)\n%s", diff)
+	}
+
+	_, _, diags = expandEditorLayout([]interface{}{
+		map[string]interface{}{
+			"group_id": "content",
+			"name":     "Content",
+			"item": []interface{}{
+				map[string]interface{}{"field_id": "title", "group_id": "seo", "name": "", "field_ids": []interface{}{}},
+			},
+		},
+	})
+	if !diags.HasError() {
+		t.Error("an item with field_id and group_id should be rejected")
+	}
+}
+
+func TestResourceEditorInterface_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+	createFakeContentType(t, meta, "space", "master", "post", "title", "slug")
+
+	path := "spaces/space/environments/master/content_types/post/editor_interface"
+	raw := map[string]interface{}{
+		"space_id":        "space",
+		"env_id":          "master",
+		"content_type_id": "post",
+		"controls": []interface{}{
+			map[string]interface{}{"field_id": "slug", "widget_id": "slugEditor", "settings": `{"trackingFieldId": "title"}`},
+			map[string]interface{}{"field_id": "title", "widget_id": "singleLine", "help_text": "The title"},
+		},
+		"sidebar": []interface{}{
+			map[string]interface{}{"widget_id": "publication-widget"},
+		},
+	}
+
+	r := resourceContentfulEditorInterface()
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:   "create",
+			config: raw,
+			check: func(t *testing.T, d *schema.ResourceData) {
+				expectControls := []interface{}{
+					map[string]interface{}{"fieldId": "slug", "widgetId": "slugEditor", "widgetNamespace": "builtin", "settings": map[string]interface{}{"trackingFieldId": "title"}},
+					map[string]interface{}{"fieldId": "title", "widgetId": "singleLine", "widgetNamespace": "builtin", "settings": map[string]interface{}{"helpText": "The title"}},
+				}
+				if diff := cmp.Diff(expectControls, server.object(path)["controls"]); diff != "" {
+					t.Errorf("controls diff (-expect, +got)\n%s", diff)
+				}
+				if got := d.Get("controls.0.settings"); got != `{"trackingFieldId": "title"}` {
+					t.Errorf("settings should keep their formatting, got %v", got)
+				}
+				if got := d.Get("sidebar.0.widget_namespace"); got != "sidebar-builtin" {
+					t.Errorf("unexpected sidebar widget namespace: %v", got)
+				}
+			},
+		},
+		{name: "plan", config: raw, planOnly: true},
+		// An editor changes the help text in the web app.
+		{
+			name: "read",
+			remote: func(id string) {
+				server.update(path, func(obj map[string]interface{}) {
+					controls := obj["controls"].([]interface{})
+					controls[1].(map[string]interface{})["settings"] = map[string]interface{}{"helpText": "Changed"}
+				})
+			},
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if got := d.Get("controls.1.help_text"); got != "Changed" {
+					t.Errorf("help_text should be refreshed, got %v", got)
+				}
+				if got := d.Get("version"); got != 3 {
+					t.Errorf("unexpected version: %v", got)
+				}
+			},
+		},
+		{name: "plan changed help text", config: raw, planOnly: true, expectChanges: true},
+	})
+}
@@ -1,10 +1,16 @@
 package contentful
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	contentful "github.com/kitagry/contentful-go"
 )
 
@@ -14,6 +20,10 @@ func resourceContentfulEntry() *schema.Resource {
 		ReadContext:   wrapEntry(resourceReadEntry),
 		UpdateContext: wrapEntry(resourceUpdateEntry),
 		DeleteContext: wrapEntry(resourceDeleteEntry),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportEntry,
+		},
+		CustomizeDiff: customdiff.All(validateEntryFields, validatePublishingState),
 
 		Schema: map[string]*schema.Schema{
 			"entry_id": {
@@ -50,8 +60,31 @@ func resourceContentfulEntry() *schema.Resource {
 							Required: true,
 						},
 						"content": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The value of Symbol and Text fields",
+						},
+						"content_json": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsJSON),
+							DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+								return jsonEqual(oldValue, newValue)
+							},
+							Description: "The JSON value of any other field, e.g. a RichText document, a number, a boolean, an Object or a Location",
+						},
+						"link": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Elem:        entryLinkResource(),
+							Description: "The entry or asset of a Link field",
+						},
+						"links": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        entryLinkResource(),
+							Description: "The entries or assets of an Array field of links",
 						},
 						"locale": {
 							Type:     schema.TypeString,
@@ -61,11 +94,35 @@ func resourceContentfulEntry() *schema.Resource {
 				},
 			},
 			"published": {
-				Type:     schema.TypeBool,
-				Required: true,
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether the entry is published. Changes applied by Terraform are published as well, and it is false while the entry has unpublished changes",
 			},
 			"archived": {
-				Type:     schema.TypeBool,
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether the entry is archived. It can't be published at the same time",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the entry: draft, changed, published or archived",
+			},
+		},
+	}
+}
+
+func entryLinkResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"link_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"Entry", "Asset"}, false)),
+				Description:      "Entry or Asset",
+			},
+			"id": {
+				Type:     schema.TypeString,
 				Required: true,
 			},
 		},
@@ -74,7 +131,7 @@ func resourceContentfulEntry() *schema.Resource {
 
 func wrapEntry(f func(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, entryClient ContentfulEntryClient) diag.Diagnostics) func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
-		client := m.(*contentful.Client)
+		client := m.(*providerClient)
 		spaceID := d.Get("space_id").(string)
 		envID := d.Get("env_id").(string)
 		env, err := client.Environments.Get(ctx, spaceID, envID)
@@ -82,38 +139,31 @@ func wrapEntry(f func(ctx context.Context, d *schema.ResourceData, env *contentf
 			diags = append(diags, contentfulErrorToDiagnostic(err)...)
 			return
 		}
-		return f(ctx, d, env, client.Entries)
+		return f(ctx, d, env, client.entries)
 	}
 }
 
 func resourceCreateEntry(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulEntryClient) (diags diag.Diagnostics) {
-	fieldProperties := map[string]interface{}{}
-	rawField := d.Get("field").([]interface{})
-	for i := 0; i < len(rawField); i++ {
-		field := rawField[i].(map[string]interface{})
-		fieldProperties[field["id"].(string)] = map[string]interface{}{}
-		fieldProperties[field["id"].(string)].(map[string]interface{})[field["locale"].(string)] = field["content"].(string)
+	fields, err := expandEntryFields(d.Get("field").([]interface{}))
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
 	}
 
 	entry := &contentful.Entry{
 		Locale: d.Get("locale").(string),
-		Fields: fieldProperties,
+		Fields: fields,
 		Sys: &contentful.Sys{
 			ID: d.Get("entry_id").(string),
 		},
 	}
 
-	err := client.Upsert(ctx, env, d.Get("contenttype_id").(string), entry)
+	err = client.Upsert(ctx, env, d.Get("contenttype_id").(string), entry)
 	if err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
 	}
 
-	if err := setEntryProperties(d, entry); err != nil {
-		diags = append(diags, contentfulErrorToDiagnostic(err)...)
-		return
-	}
-
 	d.SetId(entry.Sys.ID)
 
 	if err := setEntryState(ctx, d, env, client); err != nil {
@@ -121,7 +171,7 @@ func resourceCreateEntry(ctx context.Context, d *schema.ResourceData, env *conte
 		return
 	}
 
-	return
+	return resourceReadEntry(ctx, d, env, client)
 }
 
 func resourceUpdateEntry(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulEntryClient) (diags diag.Diagnostics) {
@@ -139,15 +189,11 @@ func resourceUpdateEntry(ctx context.Context, d *schema.ResourceData, env *conte
 		return
 	}
 
-	fieldProperties := map[string]interface{}{}
-	rawField := d.Get("field").([]interface{})
-	for i := 0; i < len(rawField); i++ {
-		field := rawField[i].(map[string]interface{})
-		fieldProperties[field["id"].(string)] = map[string]interface{}{}
-		fieldProperties[field["id"].(string)].(map[string]interface{})[field["locale"].(string)] = field["content"].(string)
+	entry.Fields, err = expandEntryFields(d.Get("field").([]interface{}))
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
 	}
-
-	entry.Fields = fieldProperties
 	entry.Locale = d.Get("locale").(string)
 
 	err = client.Upsert(ctx, env, d.Get("contenttype_id").(string), entry)
@@ -158,37 +204,38 @@ func resourceUpdateEntry(ctx context.Context, d *schema.ResourceData, env *conte
 
 	d.SetId(entry.Sys.ID)
 
-	if err := setEntryProperties(d, entry); err != nil {
-		diags = append(diags, contentfulErrorToDiagnostic(err)...)
-		return
-	}
-
 	if err := setEntryState(ctx, d, env, client); err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
 	}
 
-	return
+	return resourceReadEntry(ctx, d, env, client)
 }
 
-func setEntryState(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulEntryClient) (err error) {
-	entryID := d.Id()
-
-	entry, _ := client.Get(ctx, env, entryID)
-
-	if d.Get("published").(bool) && entry.Sys.PublishedAt == "" {
-		err = client.Publish(ctx, env, entry)
-	} else if !d.Get("published").(bool) && entry.Sys.PublishedAt != "" {
-		err = client.Unpublish(ctx, env, entry)
-	}
+// setEntryState publishes, unpublishes, archives or unarchives the entry
+// until it has the configured state.
+func setEntryState(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulEntryClient) error {
+	return changeEntryStatus(ctx, env, client, d.Id(), d.Get("published").(bool), d.Get("archived").(bool))
+}
 
-	if d.Get("archived").(bool) && entry.Sys.ArchivedAt == "" {
-		err = client.Archive(ctx, env, entry)
-	} else if !d.Get("archived").(bool) && entry.Sys.ArchivedAt != "" {
-		err = client.Unarchive(ctx, env, entry)
+// changeEntryStatus changes the status of the entry to the given state.
+func changeEntryStatus(ctx context.Context, env *contentful.Environment, client ContentfulEntryClient, entryID string, published, archived bool) error {
+	var entry *contentful.Entry
+	get := func() (*contentful.Sys, error) {
+		var err error
+		entry, err = client.Get(ctx, env, entryID)
+		if err != nil {
+			return nil, err
+		}
+		return entry.Sys, nil
 	}
 
-	return err
+	return changePublishingStatus(published, archived, get, map[string]func() error{
+		publishingActionPublish:   func() error { return client.Publish(ctx, env, entry) },
+		publishingActionUnpublish: func() error { return client.Unpublish(ctx, env, entry) },
+		publishingActionArchive:   func() error { return client.Archive(ctx, env, entry) },
+		publishingActionUnarchive: func() error { return client.Unarchive(ctx, env, entry) },
+	})
 }
 
 func resourceReadEntry(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulEntryClient) (diags diag.Diagnostics) {
@@ -216,13 +263,20 @@ func resourceReadEntry(ctx context.Context, d *schema.ResourceData, env *content
 func resourceDeleteEntry(ctx context.Context, d *schema.ResourceData, env *contentful.Environment, client ContentfulEntryClient) (diags diag.Diagnostics) {
 	entryID := d.Id()
 
-	_, err := client.Get(ctx, env, entryID)
+	// Published and archived entries can't be deleted.
+	err := changeEntryStatus(ctx, env, client, entryID, false, false)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		return nil
+	}
 	if err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
 	}
 
 	err = client.Delete(ctx, env, entryID)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		return nil
+	}
 	if err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
@@ -230,6 +284,41 @@ func resourceDeleteEntry(ctx context.Context, d *schema.ResourceData, env *conte
 	return
 }
 
+func resourceImportEntry(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ids, err := parseImportID(d.Id(), "space_id", "env_id", "entry_id")
+	if err != nil {
+		return nil, err
+	}
+
+	client := m.(*providerClient)
+
+	env, err := client.Environments.Get(ctx, ids[0], ids[1])
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := client.entries.Get(ctx, env, ids[2])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("env_id", ids[1]); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("entry_id", entry.Sys.ID); err != nil {
+		return nil, err
+	}
+
+	if err := setEntryProperties(d, entry); err != nil {
+		return nil, err
+	}
+
+	d.SetId(entry.Sys.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func setEntryProperties(d *schema.ResourceData, entry *contentful.Entry) (err error) {
 	if err = d.Set("space_id", entry.Sys.Space.Sys.ID); err != nil {
 		return err
@@ -243,5 +332,241 @@ func setEntryProperties(d *schema.ResourceData, entry *contentful.Entry) (err er
 		return err
 	}
 
+	if err = d.Set("field", flattenEntryFields(entry.Fields, d.Get("field").([]interface{}))); err != nil {
+		return err
+	}
+
+	status := publishingStatus(entry.Sys)
+	if err = d.Set("status", status); err != nil {
+		return err
+	}
+
+	if err = d.Set("published", status == publishingStatusPublished); err != nil {
+		return err
+	}
+
+	if err = d.Set("archived", status == publishingStatusArchived); err != nil {
+		return err
+	}
+
 	return err
 }
+
+// validateEntryFields checks that no field block sets more than one value.
+func validateEntryFields(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("field") {
+		return nil
+	}
+
+	for i, rawField := range d.Get("field").([]interface{}) {
+		field, ok := rawField.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if err := checkEntryFieldValue(field); err != nil {
+			return fmt.Errorf("field.%d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func checkEntryFieldValue(field map[string]interface{}) error {
+	var set []string
+	for _, key := range []string{"content", "content_json"} {
+		if v, _ := field[key].(string); v != "" {
+			set = append(set, key)
+		}
+	}
+	for _, key := range []string{"link", "links"} {
+		if v, _ := field[key].([]interface{}); len(v) > 0 {
+			set = append(set, key)
+		}
+	}
+	if len(set) > 1 {
+		return fmt.Errorf("only one of content, content_json, link and links can be set, got %v", set)
+	}
+
+	return nil
+}
+
+// expandEntryFields converts the "field" list into the localized fields of an
+// entry. content_json is decoded so that its value is sent as is, numbers keep
+// their precision.
+func expandEntryFields(rawField []interface{}) (map[string]interface{}, error) {
+	fieldProperties := map[string]interface{}{}
+	for i := 0; i < len(rawField); i++ {
+		field := rawField[i].(map[string]interface{})
+		id := field["id"].(string)
+		if _, ok := fieldProperties[id]; !ok {
+			fieldProperties[id] = map[string]interface{}{}
+		}
+
+		if err := checkEntryFieldValue(field); err != nil {
+			return nil, fmt.Errorf("field.%d: %w", i, err)
+		}
+
+		var value interface{} = field["content"].(string)
+		if contentJSON, _ := field["content_json"].(string); contentJSON != "" {
+			decoder := json.NewDecoder(bytes.NewReader([]byte(contentJSON)))
+			decoder.UseNumber()
+			if err := decoder.Decode(&value); err != nil {
+				return nil, fmt.Errorf("field.%d: content_json is invalid: %w", i, err)
+			}
+		}
+		if link, _ := field["link"].([]interface{}); len(link) > 0 {
+			value = expandEntryLink(link[0])
+		}
+		if links, _ := field["links"].([]interface{}); len(links) > 0 {
+			values := make([]interface{}, 0, len(links))
+			for _, link := range links {
+				values = append(values, expandEntryLink(link))
+			}
+			value = values
+		}
+
+		fieldProperties[id].(map[string]interface{})[field["locale"].(string)] = value
+	}
+	return fieldProperties, nil
+}
+
+func expandEntryLink(rawLink interface{}) map[string]interface{} {
+	link, _ := rawLink.(map[string]interface{})
+	return map[string]interface{}{
+		"sys": map[string]interface{}{
+			"type":     "Link",
+			"linkType": link["link_type"],
+			"id":       link["id"],
+		},
+	}
+}
+
+// flattenEntryFields converts the localized fields of an entry into the "field" list.
+// Fields already known in currentFields keep their position, fields which only
+// exist in Contentful are appended sorted by id and locale.
+func flattenEntryFields(fields map[string]interface{}, currentFields []interface{}) []interface{} {
+	result := make([]interface{}, 0)
+	seen := map[string]map[string]bool{}
+
+	for _, rawField := range currentFields {
+		field, ok := rawField.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id, _ := field["id"].(string)
+		locale, _ := field["locale"].(string)
+
+		localized, ok := fields[id].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		value, ok := localized[locale]
+		if !ok {
+			continue
+		}
+
+		if seen[id] == nil {
+			seen[id] = map[string]bool{}
+		}
+		seen[id][locale] = true
+		result = append(result, flattenEntryField(id, locale, value, field))
+	}
+
+	ids := make([]string, 0, len(fields))
+	for id := range fields {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		localized, ok := fields[id].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		locales := make([]string, 0, len(localized))
+		for locale := range localized {
+			locales = append(locales, locale)
+		}
+		sort.Strings(locales)
+
+		for _, locale := range locales {
+			if seen[id][locale] {
+				continue
+			}
+			result = append(result, flattenEntryField(id, locale, localized[locale], nil))
+		}
+	}
+
+	return result
+}
+
+// flattenEntryField sets strings as content, links as link or links and
+// other values as content_json, unless current already uses content_json for
+// the field.
+func flattenEntryField(id, locale string, value interface{}, current map[string]interface{}) map[string]interface{} {
+	field := map[string]interface{}{
+		"id":           id,
+		"locale":       locale,
+		"content":      "",
+		"content_json": "",
+		"link":         []interface{}{},
+		"links":        []interface{}{},
+	}
+
+	currentJSON, _ := current["content_json"].(string)
+	if currentJSON == "" {
+		if content, ok := value.(string); ok {
+			field["content"] = content
+			return field
+		}
+		if link, ok := flattenEntryLink(value); ok {
+			field["link"] = []interface{}{link}
+			return field
+		}
+		if values, ok := value.([]interface{}); ok && len(values) > 0 {
+			links := make([]interface{}, 0, len(values))
+			for _, v := range values {
+				link, ok := flattenEntryLink(v)
+				if !ok {
+					break
+				}
+				links = append(links, link)
+			}
+			if len(links) == len(values) {
+				field["links"] = links
+				return field
+			}
+		}
+	}
+
+	b, _ := json.Marshal(value)
+	contentJSON := string(b)
+	if jsonEqual(currentJSON, contentJSON) {
+		contentJSON = currentJSON
+	}
+	field["content_json"] = contentJSON
+
+	return field
+}
+
+// flattenEntryLink returns the link block of value if it is a link to an
+// entry or an asset.
+func flattenEntryLink(value interface{}) (map[string]interface{}, bool) {
+	v, _ := value.(map[string]interface{})
+	sys, _ := v["sys"].(map[string]interface{})
+	if len(v) != 1 || sys["type"] != "Link" {
+		return nil, false
+	}
+
+	linkType, _ := sys["linkType"].(string)
+	id, _ := sys["id"].(string)
+	if linkType != "Entry" && linkType != "Asset" {
+		return nil, false
+	}
+
+	return map[string]interface{}{
+		"link_type": linkType,
+		"id":        id,
+	}, true
+}
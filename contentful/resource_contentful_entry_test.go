@@ -0,0 +1,624 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestFlattenEntryFields(t *testing.T) {
+	tests := map[string]struct {
+		fields        map[string]interface{}
+		currentFields []interface{}
+
+		expect []interface{}
+	}{
+		"keep current order": {
+			fields: map[string]interface{}{
+				"a": map[string]interface{}{"en-US": "a-en"},
+				"b": map[string]interface{}{"en-US": "b-en", "de": "b-de"},
+			},
+			currentFields: []interface{}{
+				map[string]interface{}{"id": "b", "locale": "de", "content": "old"},
+				map[string]interface{}{"id": "a", "locale": "en-US", "content": "a-en"},
+			},
+			expect: []interface{}{
+				map[string]interface{}{"id": "b", "locale": "de", "content": "b-de", "content_json": "", "link": []interface{}{}, "links": []interface{}{}},
+				map[string]interface{}{"id": "a", "locale": "en-US", "content": "a-en", "content_json": "", "link": []interface{}{}, "links": []interface{}{}},
+				map[string]interface{}{"id": "b", "locale": "en-US", "content": "b-en", "content_json": "", "link": []interface{}{}, "links": []interface{}{}},
+			},
+		},
+		"removed field": {
+			fields: map[string]interface{}{
+				"a": map[string]interface{}{"en-US": "a-en"},
+			},
+			currentFields: []interface{}{
+				map[string]interface{}{"id": "a", "locale": "en-US", "content": "a-en"},
+				map[string]interface{}{"id": "b", "locale": "en-US", "content": "b-en"},
+			},
+			expect: []interface{}{
+				map[string]interface{}{"id": "a", "locale": "en-US", "content": "a-en", "content_json": "", "link": []interface{}{}, "links": []interface{}{}},
+			},
+		},
+		"non string value": {
+			fields: map[string]interface{}{
+				"a": map[string]interface{}{"en-US": 1.5},
+				"b": map[string]interface{}{"en-US": map[string]interface{}{"lat": 52.5, "lon": 13.4}},
+			},
+			expect: []interface{}{
+				map[string]interface{}{"id": "a", "locale": "en-US", "content": "", "content_json": "1.5", "link": []interface{}{}, "links": []interface{}{}},
+				map[string]interface{}{"id": "b", "locale": "en-US", "content": "", "content_json": `{"lat":52.5,"lon":13.4}`, "link": []interface{}{}, "links": []interface{}{}},
+			},
+		},
+		"links": {
+			fields: map[string]interface{}{
+				"author": map[string]interface{}{"en-US": map[string]interface{}{
+					"sys": map[string]interface{}{"type": "Link", "linkType": "Entry", "id": "author1"},
+				}},
+				"images": map[string]interface{}{"en-US": []interface{}{
+					map[string]interface{}{"sys": map[string]interface{}{"type": "Link", "linkType": "Asset", "id": "image1"}},
+					map[string]interface{}{"sys": map[string]interface{}{"type": "Link", "linkType": "Asset", "id": "image2"}},
+				}},
+				"tags": map[string]interface{}{"en-US": []interface{}{"a", "b"}},
+			},
+			expect: []interface{}{
+				map[string]interface{}{"id": "author", "locale": "en-US", "content": "", "content_json": "", "link": []interface{}{
+					map[string]interface{}{"link_type": "Entry", "id": "author1"},
+				}, "links": []interface{}{}},
+				map[string]interface{}{"id": "images", "locale": "en-US", "content": "", "content_json": "", "link": []interface{}{}, "links": []interface{}{
+					map[string]interface{}{"link_type": "Asset", "id": "image1"},
+					map[string]interface{}{"link_type": "Asset", "id": "image2"},
+				}},
+				map[string]interface{}{"id": "tags", "locale": "en-US", "content": "", "content_json": `["a","b"]`, "link": []interface{}{}, "links": []interface{}{}},
+			},
+		},
+		"keep current content_json": {
+			fields: map[string]interface{}{
+				"a": map[string]interface{}{"en-US": "quoted"},
+				"b": map[string]interface{}{"en-US": map[string]interface{}{"lat": 52.5, "lon": 13.4}},
+			},
+			currentFields: []interface{}{
+				map[string]interface{}{"id": "a", "locale": "en-US", "content": "", "content_json": `"quoted"`, "link": []interface{}{}, "links": []interface{}{}},
+				map[string]interface{}{"id": "b", "locale": "en-US", "content": "", "content_json": `{ "lon": 13.4, "lat": 52.5 }`, "link": []interface{}{}, "links": []interface{}{}},
+			},
+			expect: []interface{}{
+				map[string]interface{}{"id": "a", "locale": "en-US", "content": "", "content_json": `"quoted"`, "link": []interface{}{}, "links": []interface{}{}},
+				map[string]interface{}{"id": "b", "locale": "en-US", "content": "", "content_json": `{ "lon": 13.4, "lat": 52.5 }`, "link": []interface{}{}, "links": []interface{}{}},
+			},
+		},
+	}
+
+	for n, tt := range tests {
+		t.Run(n, func(t *testing.T) {
+			got := flattenEntryFields(tt.fields, tt.currentFields)
+			if diff := cmp.Diff(tt.expect, got); diff != "" {
+				t.Errorf("flattenEntryFields result diff (-expect, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandEntryFields(t *testing.T) {
+	tests := map[string]struct {
+		rawField []interface{}
+
+		expect    map[string]interface{}
+		expectErr bool
+	}{
+		"content": {
+			rawField: []interface{}{
+				map[string]interface{}{"id": "a", "locale": "en-US", "content": "a-en", "content_json": "", "link": []interface{}{}, "links": []interface{}{}},
+				map[string]interface{}{"id": "a", "locale": "de", "content": "a-de", "content_json": "", "link": []interface{}{}, "links": []interface{}{}},
+			},
+			expect: map[string]interface{}{
+				"a": map[string]interface{}{"en-US": "a-en", "de": "a-de"},
+			},
+		},
+		"content_json": {
+			rawField: []interface{}{
+				map[string]interface{}{"id": "count", "locale": "en-US", "content": "", "content_json": "12345678901234567890", "link": []interface{}{}, "links": []interface{}{}},
+				map[string]interface{}{"id": "done", "locale": "en-US", "content": "", "content_json": "true", "link": []interface{}{}, "links": []interface{}{}},
+				map[string]interface{}{"id": "body", "locale": "en-US", "content": "", "content_json": `{"nodeType": "document", "data": {}, "content": []}`, "link": []interface{}{}, "links": []interface{}{}},
+			},
+			expect: map[string]interface{}{
+				"count": map[string]interface{}{"en-US": json.Number("12345678901234567890")},
+				"done":  map[string]interface{}{"en-US": true},
+				"body": map[string]interface{}{"en-US": map[string]interface{}{
+					"nodeType": "document",
+					"data":     map[string]interface{}{},
+					"content":  []interface{}{},
+				}},
+			},
+		},
+		"links": {
+			rawField: []interface{}{
+				map[string]interface{}{"id": "author", "locale": "en-US", "content": "", "content_json": "", "link": []interface{}{
+					map[string]interface{}{"link_type": "Entry", "id": "author1"},
+				}, "links": []interface{}{}},
+				map[string]interface{}{"id": "images", "locale": "en-US", "content": "", "content_json": "", "link": []interface{}{}, "links": []interface{}{
+					map[string]interface{}{"link_type": "Asset", "id": "image1"},
+				}},
+			},
+			expect: map[string]interface{}{
+				"author": map[string]interface{}{"en-US": map[string]interface{}{
+					"sys": map[string]interface{}{"type": "Link", "linkType": "Entry", "id": "author1"},
+				}},
+				"images": map[string]interface{}{"en-US": []interface{}{
+					map[string]interface{}{"sys": map[string]interface{}{"type": "Link", "linkType": "Asset", "id": "image1"}},
+				}},
+			},
+		},
+		"content and link": {
+			rawField: []interface{}{
+				map[string]interface{}{"id": "a", "locale": "en-US", "content": "a", "content_json": "", "link": []interface{}{
+					map[string]interface{}{"link_type": "Entry", "id": "b"},
+				}, "links": []interface{}{}},
+			},
+			expectErr: true,
+		},
+		"content and content_json": {
+			rawField: []interface{}{
+				map[string]interface{}{"id": "a", "locale": "en-US", "content": "a", "content_json": `"a"`, "link": []interface{}{}, "links": []interface{}{}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for n, tt := range tests {
+		t.Run(n, func(t *testing.T) {
+			got, err := expandEntryFields(tt.rawField)
+			if tt.expectErr != (err != nil) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.expect, got); diff != "" {
+				t.Errorf("expandEntryFields result diff (-expect, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceEntry_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+	ctx := context.Background()
+
+	ct := resourceContentfulContentType()
+	ctData := schema.TestResourceDataRaw(t, ct.Schema, map[string]interface{}{
+		"space_id":        "space",
+		"env_id":          "master",
+		"content_type_id": "post",
+		"name":            "Post",
+		"display_field":   "title",
+		"field": []interface{}{
+			map[string]interface{}{"id": "title", "name": "Title", "type": "Symbol", "required": true},
+		},
+	})
+	if diags := ct.CreateContext(ctx, ctData, meta); diags.HasError() {
+		t.Fatalf("failed to create content type: %v", diags)
+	}
+
+	r := resourceContentfulEntry()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"entry_id":       "post1",
+		"space_id":       "space",
+		"env_id":         "master",
+		"contenttype_id": "post",
+		"locale":         "en-US",
+		"field": []interface{}{
+			map[string]interface{}{"id": "title", "locale": "en-US", "content": "Hello"},
+		},
+		"published": true,
+		"archived":  false,
+	})
+	if diags := r.CreateContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("failed to create entry: %v", diags)
+	}
+
+	path := "spaces/space/environments/master/entries/post1"
+	if sys := server.object(path)["sys"].(map[string]interface{}); sys["publishedAt"] == nil {
+		t.Errorf("entry should be published: %v", sys)
+	}
+
+	// An editor changes the entry in the web app.
+	server.update(path, func(obj map[string]interface{}) {
+		obj["fields"].(map[string]interface{})["title"].(map[string]interface{})["en-US"] = "Changed"
+	})
+
+	d = r.Data(d.State())
+	if diags := r.ReadContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("failed to read entry: %v", diags)
+	}
+	if got := d.Get("field.0.content"); got != "Changed" {
+		t.Errorf("field.0.content should be refreshed, got %v", got)
+	}
+	if got := d.Get("published"); got != false {
+		t.Errorf("published should be false for unpublished changes, got %v", got)
+	}
+
+	if err := d.Set("published", false); err != nil {
+		t.Fatal(err)
+	}
+	if diags := r.UpdateContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("failed to update entry: %v", diags)
+	}
+	if sys := server.object(path)["sys"].(map[string]interface{}); sys["publishedAt"] != nil {
+		t.Errorf("entry should be unpublished: %v", sys)
+	}
+
+	if diags := r.DeleteContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("failed to delete entry: %v", diags)
+	}
+	if obj := server.object(path); obj != nil {
+		t.Errorf("entry should be deleted: %v", obj)
+	}
+}
+
+func TestResourceEntryContentJSON_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+	ctx := context.Background()
+
+	ct := resourceContentfulContentType()
+	ctData := schema.TestResourceDataRaw(t, ct.Schema, map[string]interface{}{
+		"space_id":        "space",
+		"env_id":          "master",
+		"content_type_id": "post",
+		"name":            "Post",
+		"display_field":   "title",
+		"field": []interface{}{
+			map[string]interface{}{"id": "title", "name": "Title", "type": "Symbol"},
+			map[string]interface{}{"id": "body", "name": "Body", "type": "RichText"},
+			map[string]interface{}{"id": "rating", "name": "Rating", "type": "Integer"},
+			map[string]interface{}{"id": "place", "name": "Place", "type": "Location"},
+		},
+	})
+	if diags := ct.CreateContext(ctx, ctData, meta); diags.HasError() {
+		t.Fatalf("failed to create content type: %v", diags)
+	}
+
+	body := `{
+  "nodeType": "document",
+  "data": {},
+  "content": [
+    {
+      "nodeType": "paragraph",
+      "data": {},
+      "content": [{"nodeType": "text", "value": "Hello", "marks": [], "data": {}}]
+    }
+  ]
+}`
+	r := resourceContentfulEntry()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"entry_id":       "post1",
+		"space_id":       "space",
+		"env_id":         "master",
+		"contenttype_id": "post",
+		"locale":         "en-US",
+		"field": []interface{}{
+			map[string]interface{}{"id": "title", "locale": "en-US", "content": "Hello"},
+			map[string]interface{}{"id": "body", "locale": "en-US", "content_json": body},
+			map[string]interface{}{"id": "rating", "locale": "en-US", "content_json": "5", "link": []interface{}{}, "links": []interface{}{}},
+			map[string]interface{}{"id": "place", "locale": "en-US", "content_json": `{"lat": 52.52, "lon": 13.40}`, "link": []interface{}{}, "links": []interface{}{}},
+		},
+		"published": false,
+		"archived":  false,
+	})
+	if diags := r.CreateContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("failed to create entry: %v", diags)
+	}
+
+	fields := server.object("spaces/space/environments/master/entries/post1")["fields"].(map[string]interface{})
+	expectSent := map[string]interface{}{
+		"rating": map[string]interface{}{"en-US": float64(5)},
+		"place":  map[string]interface{}{"en-US": map[string]interface{}{"lat": 52.52, "lon": 13.40}},
+	}
+	for id, v := range expectSent {
+		if diff := cmp.Diff(v, fields[id]); diff != "" {
+			t.Errorf("sent %s diff (-expect, +got)\n%s", id, diff)
+		}
+	}
+	if nodeType := fields["body"].(map[string]interface{})["en-US"].(map[string]interface{})["nodeType"]; nodeType != "document" {
+		t.Errorf("body should be sent as a document, got %v", fields["body"])
+	}
+
+	// The configured JSON is kept as long as it is equivalent.
+	expect := map[string]interface{}{
+		"field.0.content":      "Hello",
+		"field.1.content_json": body,
+		"field.2.content_json": "5",
+		"field.3.content_json": `{"lat": 52.52, "lon": 13.40}`,
+	}
+	for k, v := range expect {
+		if diff := cmp.Diff(v, d.Get(k)); diff != "" {
+			t.Errorf("%s diff (-expect, +got)\n%s", k, diff)
+		}
+	}
+}
+
+func TestResourceEntryLinks_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+	ctx := context.Background()
+
+	ct := resourceContentfulContentType()
+	ctData := schema.TestResourceDataRaw(t, ct.Schema, map[string]interface{}{
+		"space_id":        "space",
+		"env_id":          "master",
+		"content_type_id": "post",
+		"name":            "Post",
+		"display_field":   "title",
+		"field": []interface{}{
+			map[string]interface{}{"id": "title", "name": "Title", "type": "Symbol"},
+			map[string]interface{}{"id": "parent", "name": "Parent", "type": "Link", "link_type": "Entry"},
+			map[string]interface{}{"id": "related", "name": "Related", "type": "Array", "items": []interface{}{
+				map[string]interface{}{"type": "Link", "link_type": "Entry"},
+			}},
+		},
+	})
+	if diags := ct.CreateContext(ctx, ctData, meta); diags.HasError() {
+		t.Fatalf("failed to create content type: %v", diags)
+	}
+
+	r := resourceContentfulEntry()
+	for _, id := range []string{"post1", "post2"} {
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+			"entry_id":       id,
+			"space_id":       "space",
+			"env_id":         "master",
+			"contenttype_id": "post",
+			"locale":         "en-US",
+			"field": []interface{}{
+				map[string]interface{}{"id": "title", "locale": "en-US", "content": id},
+			},
+			"published": false,
+			"archived":  false,
+		})
+		if diags := r.CreateContext(ctx, d, meta); diags.HasError() {
+			t.Fatalf("failed to create entry: %v", diags)
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"entry_id":       "post3",
+		"space_id":       "space",
+		"env_id":         "master",
+		"contenttype_id": "post",
+		"locale":         "en-US",
+		"field": []interface{}{
+			map[string]interface{}{"id": "parent", "locale": "en-US", "link": []interface{}{
+				map[string]interface{}{"link_type": "Entry", "id": "post1"},
+			}},
+			map[string]interface{}{"id": "related", "locale": "en-US", "links": []interface{}{
+				map[string]interface{}{"link_type": "Entry", "id": "post1"},
+				map[string]interface{}{"link_type": "Entry", "id": "post2"},
+			}},
+		},
+		"published": false,
+		"archived":  false,
+	})
+	if diags := r.CreateContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("failed to create entry: %v", diags)
+	}
+
+	fields := server.object("spaces/space/environments/master/entries/post3")["fields"].(map[string]interface{})
+	expectSent := map[string]interface{}{
+		"parent": map[string]interface{}{"en-US": fakeLink("Entry", "post1")},
+		"related": map[string]interface{}{"en-US": []interface{}{
+			fakeLink("Entry", "post1"),
+			fakeLink("Entry", "post2"),
+		}},
+	}
+	if diff := cmp.Diff(expectSent, fields); diff != "" {
+		t.Errorf("sent fields diff (-expect, +got)\n%s", diff)
+	}
+
+	expect := map[string]interface{}{
+		"field.0.link.0.id":         "post1",
+		"field.0.content_json":      "",
+		"field.1.links.#":           2,
+		"field.1.links.1.link_type": "Entry",
+		"field.1.links.1.id":        "post2",
+	}
+	for k, v := range expect {
+		if diff := cmp.Diff(v, d.Get(k)); diff != "" {
+			t.Errorf("%s diff (-expect, +got)\n%s", k, diff)
+		}
+	}
+}
+
+func TestResourceEntryPublishingState_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+	ctx := context.Background()
+
+	ct := resourceContentfulContentType()
+	ctData := schema.TestResourceDataRaw(t, ct.Schema, map[string]interface{}{
+		"space_id":        "space",
+		"env_id":          "master",
+		"content_type_id": "post",
+		"name":            "Post",
+		"display_field":   "title",
+		"field": []interface{}{
+			map[string]interface{}{"id": "title", "name": "Title", "type": "Symbol"},
+		},
+	})
+	if diags := ct.CreateContext(ctx, ctData, meta); diags.HasError() {
+		t.Fatalf("failed to create content type: %v", diags)
+	}
+
+	r := resourceContentfulEntry()
+	raw := map[string]interface{}{
+		"entry_id":       "post1",
+		"space_id":       "space",
+		"env_id":         "master",
+		"contenttype_id": "post",
+		"locale":         "en-US",
+		"field": []interface{}{
+			map[string]interface{}{"id": "title", "locale": "en-US", "content": "Hello"},
+		},
+		"published": true,
+		"archived":  false,
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	if diags := r.CreateContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("failed to create entry: %v", diags)
+	}
+	if got := d.Get("status"); got != publishingStatusPublished {
+		t.Errorf("expected status %q, got %q", publishingStatusPublished, got)
+	}
+
+	// An editor changes the published entry in the web app.
+	path := "spaces/space/environments/master/entries/post1"
+	server.update(path, func(obj map[string]interface{}) {})
+	d = r.Data(d.State())
+	if diags := r.ReadContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("failed to read entry: %v", diags)
+	}
+	if d.Get("status") != publishingStatusChanged || d.Get("published") != false {
+		t.Errorf("entry should have unpublished changes: %v", d.State().Attributes)
+	}
+
+	// The changes show up in the plan and are published by the update.
+	diff, err := r.Diff(ctx, d.State(), terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		t.Fatalf("failed to plan entry: %v", err)
+	}
+	if diff == nil || diff.Attributes["published"] == nil {
+		t.Fatalf("published should be planned, got %v", diff)
+	}
+	if err := d.Set("published", true); err != nil {
+		t.Fatal(err)
+	}
+	if diags := r.UpdateContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("failed to publish entry: %v", diags)
+	}
+	if got := d.Get("status"); got != publishingStatusPublished {
+		t.Errorf("expected status %q, got %q", publishingStatusPublished, got)
+	}
+
+	tests := []struct {
+		name         string
+		published    bool
+		archived     bool
+		expectStatus string
+	}{
+		// Published entries are unpublished before they are archived.
+		{name: "archive published entry", archived: true, expectStatus: publishingStatusArchived},
+		// Archived entries are unarchived before they are published.
+		{name: "publish archived entry", published: true, expectStatus: publishingStatusPublished},
+		{name: "unpublish entry", expectStatus: publishingStatusDraft},
+	}
+	for _, tt := range tests {
+		d = r.Data(d.State())
+		if err := d.Set("published", tt.published); err != nil {
+			t.Fatal(err)
+		}
+		if err := d.Set("archived", tt.archived); err != nil {
+			t.Fatal(err)
+		}
+		if diags := r.UpdateContext(ctx, d, meta); diags.HasError() {
+			t.Fatalf("%s: failed to update entry: %v", tt.name, diags)
+		}
+		if got := d.Get("status"); got != tt.expectStatus {
+			t.Errorf("%s: expected status %q, got %q", tt.name, tt.expectStatus, got)
+		}
+	}
+
+	// Entries removed in the web app can't be updated.
+	server.remove(path)
+	d = r.Data(d.State())
+	if diags := r.UpdateContext(ctx, d, meta); !diags.HasError() {
+		t.Error("updating a removed entry should fail")
+	}
+
+	// Entries are only removed from the state when they are not found.
+	server.fail(http.MethodGet, path, http.StatusForbidden)
+	d = r.Data(d.State())
+	if diags := r.ReadContext(ctx, d, meta); !diags.HasError() || d.Id() != "post1" {
+		t.Errorf("reading without access should fail and keep the entry, got ID %q: %v", d.Id(), diags)
+	}
+}
+
+func TestResourceEntryDelete_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+	ctx := context.Background()
+
+	ct := resourceContentfulContentType()
+	ctData := schema.TestResourceDataRaw(t, ct.Schema, map[string]interface{}{
+		"space_id":        "space",
+		"env_id":          "master",
+		"content_type_id": "post",
+		"name":            "Post",
+		"display_field":   "title",
+		"field": []interface{}{
+			map[string]interface{}{"id": "title", "name": "Title", "type": "Symbol"},
+		},
+	})
+	if diags := ct.CreateContext(ctx, ctData, meta); diags.HasError() {
+		t.Fatalf("failed to create content type: %v", diags)
+	}
+
+	tests := map[string]struct {
+		published bool
+		archived  bool
+		removed   bool
+		forbidden bool
+	}{
+		"draft":     {},
+		"published": {published: true},
+		"archived":  {archived: true},
+		"removed":   {published: true, removed: true},
+		// Only entries which are not found count as deleted.
+		"forbidden": {published: true, forbidden: true},
+	}
+
+	r := resourceContentfulEntry()
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			id := "post-" + name
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				"entry_id":       id,
+				"space_id":       "space",
+				"env_id":         "master",
+				"contenttype_id": "post",
+				"locale":         "en-US",
+				"field": []interface{}{
+					map[string]interface{}{"id": "title", "locale": "en-US", "content": "Hello"},
+				},
+				"published": tt.published,
+				"archived":  tt.archived,
+			})
+			if diags := r.CreateContext(ctx, d, meta); diags.HasError() {
+				t.Fatalf("failed to create entry: %v", diags)
+			}
+
+			path := "spaces/space/environments/master/entries/" + id
+			if tt.removed {
+				server.remove(path)
+			}
+			if tt.forbidden {
+				server.fail(http.MethodGet, path, http.StatusForbidden)
+			}
+
+			diags := r.DeleteContext(ctx, d, meta)
+			if tt.forbidden {
+				if !diags.HasError() || server.object(path) == nil {
+					t.Errorf("entry shouldn't be deleted without access: %v", diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("failed to delete entry: %v", diags)
+			}
+			if server.object(path) != nil {
+				t.Errorf("entry should be deleted: %v", server.object(path))
+			}
+		})
+	}
+}
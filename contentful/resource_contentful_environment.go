@@ -2,8 +2,11 @@ package contentful
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	contentful "github.com/kitagry/contentful-go"
 )
@@ -14,6 +17,12 @@ func resourceContentfulEnvironment() *schema.Resource {
 		ReadContext:   wrapEnvironment(resourceReadEnvironment),
 		UpdateContext: wrapEnvironment(resourceUpdateEnvironment),
 		DeleteContext: wrapEnvironment(resourceDeleteEnvironment),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportEnvironment,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"version": {
@@ -28,14 +37,20 @@ func resourceContentfulEnvironment() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"source_environment_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the environment to clone. Defaults to master",
+			},
 		},
 	}
 }
 
 func wrapEnvironment(f func(ctx context.Context, d *schema.ResourceData, apiKey ContentfulEnvironmentClient) diag.Diagnostics) func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
-		client := m.(*contentful.Client)
-		return f(ctx, d, client.Environments)
+		client := m.(*providerClient)
+		return f(ctx, d, client.environments)
 	}
 }
 
@@ -44,22 +59,58 @@ func resourceCreateEnvironment(ctx context.Context, d *schema.ResourceData, clie
 		Name: d.Get("name").(string),
 	}
 
-	err := client.Upsert(ctx, d.Get("space_id").(string), environment)
+	spaceID := d.Get("space_id").(string)
+	err := client.Create(ctx, spaceID, environment, d.Get("source_environment_id").(string))
 	if err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
 	}
 
-	if err := setEnvironmentProperties(d, environment); err != nil {
+	d.SetId(environment.Name)
+
+	// The content of the environment is copied asynchronously. Resources
+	// in the environment can only be created once it is ready.
+	if err := waitForEnvironment(ctx, client, spaceID, environment.Name, d.Timeout(schema.TimeoutCreate)); err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
 	}
 
-	d.SetId(environment.Name)
+	environment, err = client.Get(ctx, spaceID, environment.Name)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	if err := setEnvironmentProperties(d, environment); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
 
 	return nil
 }
 
+func waitForEnvironment(ctx context.Context, client ContentfulEnvironmentClient, spaceID, environmentID string, timeout time.Duration) error {
+	conf := &resource.StateChangeConf{
+		Pending: []string{"queued", "inProgress"},
+		Target:  []string{environmentStatusReady},
+		Refresh: func() (interface{}, string, error) {
+			status, err := client.Status(ctx, spaceID, environmentID)
+			if err != nil {
+				return nil, "", err
+			}
+			if status == environmentStatusFailed {
+				return nil, "", fmt.Errorf("creation of environment %q failed", environmentID)
+			}
+			return status, status, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: time.Second,
+	}
+
+	_, err := conf.WaitForStateContext(ctx)
+	return err
+}
+
 func resourceUpdateEnvironment(ctx context.Context, d *schema.ResourceData, client ContentfulEnvironmentClient) (diags diag.Diagnostics) {
 	spaceID := d.Get("space_id").(string)
 	environmentID := d.Id()
@@ -133,6 +184,28 @@ func resourceDeleteEnvironment(ctx context.Context, d *schema.ResourceData, clie
 	return
 }
 
+func resourceImportEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ids, err := parseImportID(d.Id(), "space_id", "environment_id")
+	if err != nil {
+		return nil, err
+	}
+
+	client := m.(*providerClient)
+
+	environment, err := client.Environments.Get(ctx, ids[0], ids[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setEnvironmentProperties(d, environment); err != nil {
+		return nil, err
+	}
+
+	d.SetId(environment.Sys.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func setEnvironmentProperties(d *schema.ResourceData, environment *contentful.Environment) error {
 	if err := d.Set("space_id", environment.Sys.Space.Sys.ID); err != nil {
 		return err
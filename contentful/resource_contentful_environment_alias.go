@@ -0,0 +1,209 @@
+package contentful
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	contentful "github.com/kitagry/contentful-go"
+)
+
+func resourceContentfulEnvironmentAlias() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: wrapEnvironmentAlias(resourceCreateEnvironmentAlias),
+		ReadContext:   wrapEnvironmentAlias(resourceReadEnvironmentAlias),
+		UpdateContext: wrapEnvironmentAlias(resourceUpdateEnvironmentAlias),
+		DeleteContext: wrapEnvironmentAlias(resourceDeleteEnvironmentAlias),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportEnvironmentAlias,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"alias_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the alias, e.g. master",
+			},
+			"environment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the environment the alias points to",
+			},
+		},
+	}
+}
+
+func wrapEnvironmentAlias(f func(ctx context.Context, d *schema.ResourceData, client ContentfulEnvironmentAliasClient) diag.Diagnostics) func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+		client := m.(*providerClient)
+		return f(ctx, d, client.environmentAliases)
+	}
+}
+
+func resourceCreateEnvironmentAlias(ctx context.Context, d *schema.ResourceData, client ContentfulEnvironmentAliasClient) (diags diag.Diagnostics) {
+	spaceID := d.Get("space_id").(string)
+	aliasID := d.Get("alias_id").(string)
+
+	// The master alias exists as soon as aliases are enabled for the space,
+	// so an existing alias is taken over instead of failing.
+	alias, err := client.Get(ctx, spaceID, aliasID)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		alias = &contentful.EnvironmentAlias{
+			Sys:   &contentful.Sys{ID: aliasID},
+			Alias: newEnvironmentLink(d.Get("environment_id").(string)),
+		}
+		err = client.Create(ctx, spaceID, alias)
+	} else if err == nil {
+		alias.Alias = newEnvironmentLink(d.Get("environment_id").(string))
+		err = client.Update(ctx, spaceID, alias)
+	}
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	if err := setEnvironmentAliasProperties(d, alias); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	d.SetId(alias.Sys.ID)
+
+	return nil
+}
+
+func resourceReadEnvironmentAlias(ctx context.Context, d *schema.ResourceData, client ContentfulEnvironmentAliasClient) (diags diag.Diagnostics) {
+	alias, err := client.Get(ctx, d.Get("space_id").(string), d.Id())
+	if _, ok := err.(contentful.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	if err := setEnvironmentAliasProperties(d, alias); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+	return
+}
+
+func resourceUpdateEnvironmentAlias(ctx context.Context, d *schema.ResourceData, client ContentfulEnvironmentAliasClient) (diags diag.Diagnostics) {
+	spaceID := d.Get("space_id").(string)
+	defer func() {
+		if diags.HasError() {
+			d.Partial(true)
+		}
+	}()
+
+	alias, err := client.Get(ctx, spaceID, d.Id())
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	alias.Alias = newEnvironmentLink(d.Get("environment_id").(string))
+
+	if err := client.Update(ctx, spaceID, alias); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	if err := setEnvironmentAliasProperties(d, alias); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	return nil
+}
+
+func resourceDeleteEnvironmentAlias(ctx context.Context, d *schema.ResourceData, client ContentfulEnvironmentAliasClient) (diags diag.Diagnostics) {
+	// The master alias can't be deleted, it is only removed from the state.
+	if d.Id() == "master" {
+		return nil
+	}
+
+	spaceID := d.Get("space_id").(string)
+	alias, err := client.Get(ctx, spaceID, d.Id())
+	if _, ok := err.(contentful.NotFoundError); ok {
+		return nil
+	}
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	if err := client.Delete(ctx, spaceID, alias); err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+	return
+}
+
+func resourceImportEnvironmentAlias(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ids, err := parseImportID(d.Id(), "space_id", "alias_id")
+	if err != nil {
+		return nil, err
+	}
+
+	client := m.(*providerClient)
+
+	alias, err := client.environmentAliases.Get(ctx, ids[0], ids[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("space_id", ids[0]); err != nil {
+		return nil, err
+	}
+
+	if err := setEnvironmentAliasProperties(d, alias); err != nil {
+		return nil, err
+	}
+
+	d.SetId(alias.Sys.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setEnvironmentAliasProperties(d *schema.ResourceData, alias *contentful.EnvironmentAlias) error {
+	if err := d.Set("version", alias.Sys.Version); err != nil {
+		return err
+	}
+
+	if err := d.Set("alias_id", alias.Sys.ID); err != nil {
+		return err
+	}
+
+	environmentID := ""
+	if alias.Alias != nil && alias.Alias.Sys != nil {
+		environmentID = alias.Alias.Sys.ID
+	}
+	if err := d.Set("environment_id", environmentID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func newEnvironmentLink(environmentID string) *contentful.AliasDetail {
+	return &contentful.AliasDetail{
+		Sys: &contentful.Sys{
+			ID:       environmentID,
+			Type:     "Link",
+			LinkType: "Environment",
+		},
+	}
+}
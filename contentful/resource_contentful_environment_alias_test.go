@@ -0,0 +1,100 @@
+package contentful
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceEnvironmentAlias_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+	for _, name := range []string{"blue", "green"} {
+		createFakeEnvironment(t, meta, "space", name)
+	}
+
+	aliasEnvironment := func(aliasID string) string {
+		obj := server.object("spaces/space/environment_aliases/" + aliasID)
+		if obj == nil {
+			return ""
+		}
+		return fakeLinkID(obj["environment"])
+	}
+	raw := func(aliasID, environmentID string) map[string]interface{} {
+		return map[string]interface{}{"space_id": "space", "alias_id": aliasID, "environment_id": environmentID}
+	}
+
+	// Swapping the alias is done in place.
+	r := resourceContentfulEnvironmentAlias()
+	if r.Schema["environment_id"].ForceNew {
+		t.Error("changing environment_id should not replace the alias")
+	}
+
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		// The master alias already exists and is taken over.
+		{
+			name:   "create master",
+			config: raw("master", "blue"),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if got := aliasEnvironment("master"); got != "blue" {
+					t.Errorf("master should point to blue, got %q", got)
+				}
+			},
+		},
+		// Someone swaps the alias in the web app.
+		{
+			name: "read swap",
+			remote: func(id string) {
+				server.update("spaces/space/environment_aliases/"+id, func(obj map[string]interface{}) {
+					obj["environment"] = fakeLink("Environment", "green")
+				})
+			},
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if got := d.Get("environment_id"); got != "green" {
+					t.Errorf("read should detect the swap, got %q", got)
+				}
+			},
+		},
+		{
+			name:   "swap back",
+			config: raw("master", "blue"),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if got := aliasEnvironment("master"); got != "blue" {
+					t.Errorf("master should point to blue again, got %q", got)
+				}
+			},
+		},
+		// Master is only forgotten.
+		{
+			name:    "destroy master",
+			destroy: true,
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if server.object("spaces/space/environment_aliases/master") == nil {
+					t.Error("master alias can't be deleted")
+				}
+			},
+		},
+	})
+
+	// Other aliases are created and deleted.
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:   "create staging",
+			config: raw("staging", "green"),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if got := aliasEnvironment("staging"); got != "green" {
+					t.Errorf("staging should point to green, got %q", got)
+				}
+			},
+		},
+		{
+			name:    "destroy staging",
+			destroy: true,
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if server.object("spaces/space/environment_aliases/staging") != nil {
+					t.Error("staging alias should have been deleted")
+				}
+			},
+		},
+	})
+}
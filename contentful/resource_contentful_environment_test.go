@@ -0,0 +1,44 @@
+package contentful
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceEnvironmentClone_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+	createFakeContentType(t, meta, "space", "master", "post", "title")
+
+	r := resourceContentfulEnvironment()
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:   "create",
+			config: map[string]interface{}{"space_id": "space", "name": "staging", "source_environment_id": "master"},
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if d.Id() != "staging" || d.Get("source_environment_id") != "master" {
+					t.Errorf("unexpected environment: %v", d.State().Attributes)
+				}
+
+				if server.object("spaces/space/environments/staging/content_types/post") == nil {
+					t.Error("content type should have been cloned from master")
+				}
+
+				status := server.object("spaces/space/environments/staging")["sys"].(map[string]interface{})["status"]
+				if id := status.(map[string]interface{})["sys"].(map[string]interface{})["id"]; id != environmentStatusReady {
+					t.Errorf("create should wait until the environment is ready, got status %v", id)
+				}
+			},
+		},
+	})
+
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:        "unknown source",
+			config:      map[string]interface{}{"space_id": "space", "name": "broken", "source_environment_id": "unknown"},
+			expectError: regexp.MustCompile("can not be found"),
+		},
+	})
+}
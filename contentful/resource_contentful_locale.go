@@ -14,6 +14,9 @@ func resourceContentfulLocale() *schema.Resource {
 		ReadContext:   wrapLocale(resourceReadLocale),
 		UpdateContext: wrapLocale(resourceUpdateLocale),
 		DeleteContext: wrapLocale(resourceDeleteLocale),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportLocale,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"version": {
@@ -24,6 +27,12 @@ func resourceContentfulLocale() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"env_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the environment. Without it, the locales of the space are managed",
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -58,13 +67,14 @@ func resourceContentfulLocale() *schema.Resource {
 
 func wrapLocale(f func(ctx context.Context, d *schema.ResourceData, client ContentfulLocaleClient) diag.Diagnostics) func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
-		client := m.(*contentful.Client)
-		return f(ctx, d, client.Locales)
+		client := m.(*providerClient)
+		return f(ctx, d, client.locales)
 	}
 }
 
 func resourceCreateLocale(ctx context.Context, d *schema.ResourceData, client ContentfulLocaleClient) (diags diag.Diagnostics) {
 	spaceID := d.Get("space_id").(string)
+	envID := d.Get("env_id").(string)
 
 	locale := &contentful.Locale{
 		Name:         d.Get("name").(string),
@@ -75,7 +85,7 @@ func resourceCreateLocale(ctx context.Context, d *schema.ResourceData, client Co
 		CMA:          d.Get("cma").(bool),
 	}
 
-	err := client.Upsert(ctx, spaceID, locale)
+	err := client.Upsert(ctx, spaceID, envID, locale)
 	if err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
@@ -94,10 +104,11 @@ func resourceCreateLocale(ctx context.Context, d *schema.ResourceData, client Co
 
 func resourceReadLocale(ctx context.Context, d *schema.ResourceData, client ContentfulLocaleClient) (diags diag.Diagnostics) {
 	spaceID := d.Get("space_id").(string)
+	envID := d.Get("env_id").(string)
 	localeID := d.Id()
 
-	locale, err := client.Get(ctx, spaceID, localeID)
-	if _, ok := err.(*contentful.NotFoundError); ok {
+	locale, err := client.Get(ctx, spaceID, envID, localeID)
+	if _, ok := err.(contentful.NotFoundError); ok {
 		d.SetId("")
 		return nil
 	}
@@ -117,6 +128,7 @@ func resourceReadLocale(ctx context.Context, d *schema.ResourceData, client Cont
 
 func resourceUpdateLocale(ctx context.Context, d *schema.ResourceData, client ContentfulLocaleClient) (diags diag.Diagnostics) {
 	spaceID := d.Get("space_id").(string)
+	envID := d.Get("env_id").(string)
 	localeID := d.Id()
 	defer func() {
 		if diags.HasError() {
@@ -124,7 +136,7 @@ func resourceUpdateLocale(ctx context.Context, d *schema.ResourceData, client Co
 		}
 	}()
 
-	locale, err := client.Get(ctx, spaceID, localeID)
+	locale, err := client.Get(ctx, spaceID, envID, localeID)
 	if err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
@@ -137,7 +149,7 @@ func resourceUpdateLocale(ctx context.Context, d *schema.ResourceData, client Co
 	locale.CDA = d.Get("cda").(bool)
 	locale.CMA = d.Get("cma").(bool)
 
-	err = client.Upsert(ctx, spaceID, locale)
+	err = client.Upsert(ctx, spaceID, envID, locale)
 	if err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
@@ -154,16 +166,21 @@ func resourceUpdateLocale(ctx context.Context, d *schema.ResourceData, client Co
 
 func resourceDeleteLocale(ctx context.Context, d *schema.ResourceData, client ContentfulLocaleClient) (diags diag.Diagnostics) {
 	spaceID := d.Get("space_id").(string)
+	envID := d.Get("env_id").(string)
 	localeID := d.Id()
 
-	locale, err := client.Get(ctx, spaceID, localeID)
+	// The locale is already gone if it was removed in the web app.
+	locale, err := client.Get(ctx, spaceID, envID, localeID)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		return nil
+	}
 	if err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
 	}
 
-	err = client.Delete(ctx, spaceID, locale)
-	if _, ok := err.(*contentful.NotFoundError); ok {
+	err = client.Delete(ctx, spaceID, envID, locale)
+	if _, ok := err.(contentful.NotFoundError); ok {
 		return nil
 	}
 
@@ -175,6 +192,41 @@ func resourceDeleteLocale(ctx context.Context, d *schema.ResourceData, client Co
 	return nil
 }
 
+func resourceImportLocale(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ids, err := parseImportID(d.Id(), "space_id", "env_id", "locale_id")
+	if err != nil {
+		// Locales of the space are imported without env_id.
+		spaceIDs, spaceErr := parseImportID(d.Id(), "space_id", "locale_id")
+		if spaceErr != nil {
+			return nil, err
+		}
+		ids = []string{spaceIDs[0], "", spaceIDs[1]}
+	}
+
+	client := m.(*providerClient)
+
+	locale, err := client.locales.Get(ctx, ids[0], ids[1], ids[2])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("space_id", ids[0]); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("env_id", ids[1]); err != nil {
+		return nil, err
+	}
+
+	if err := setLocaleProperties(d, locale); err != nil {
+		return nil, err
+	}
+
+	d.SetId(locale.Sys.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func setLocaleProperties(d *schema.ResourceData, locale *contentful.Locale) error {
 	err := d.Set("name", locale.Name)
 	if err != nil {
@@ -0,0 +1,145 @@
+package contentful
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceLocaleEnvironment_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+	createFakeEnvironment(t, meta, "space", "staging")
+
+	raw := func(name string) map[string]interface{} {
+		return map[string]interface{}{"space_id": "space", "env_id": "staging", "name": name, "code": "fr-FR"}
+	}
+
+	r := resourceContentfulLocale()
+	d := testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:   "create",
+			config: raw("French"),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if obj := server.object("spaces/space/environments/staging/locales/" + d.Id()); obj["code"] != "fr-FR" {
+					t.Fatalf("locale should be created in staging: %v", obj)
+				}
+			},
+		},
+		{
+			name:   "update",
+			config: raw("Français"),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if obj := server.object("spaces/space/environments/staging/locales/" + d.Id()); obj["name"] != "Français" {
+					t.Errorf("locale should be updated in staging: %v", obj)
+				}
+			},
+		},
+	})
+
+	notFound := regexp.MustCompile("can not be found")
+	tests := map[string]struct {
+		id          string
+		expectEnv   string
+		expectError *regexp.Regexp
+	}{
+		"with environment":    {id: "space/staging/" + d.Id(), expectEnv: "staging"},
+		"without environment": {id: "space/" + d.Id(), expectError: notFound},
+		"other environment":   {id: "space/master/" + d.Id(), expectError: notFound},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testFakeResource(t, r, meta, []fakeResourceStep{
+				{
+					name:        "import",
+					importID:    tt.id,
+					expectError: tt.expectError,
+					check: func(t *testing.T, d *schema.ResourceData) {
+						if tt.expectError == nil && (d.Get("env_id") != tt.expectEnv || d.Get("code") != "fr-FR") {
+							t.Errorf("unexpected state: %v", d.State().Attributes)
+						}
+					},
+				},
+			})
+		})
+	}
+
+	// Without env_id, the locales of the space are used.
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:   "create",
+			config: map[string]interface{}{"space_id": "space", "name": "German", "code": "de"},
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if server.object("spaces/space/locales/"+d.Id()) == nil {
+					t.Error("locale should be created in the space")
+				}
+			},
+		},
+		{
+			name: "read removed locale",
+			remote: func(id string) {
+				server.remove("spaces/space/locales/" + id)
+			},
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if d.Id() != "" {
+					t.Errorf("removed locale should be removed from the state, got ID %q", d.Id())
+				}
+			},
+		},
+	})
+
+	d, diags := readDataSource(t, dataSourceContentfulLocale(), map[string]interface{}{
+		"space_id": "space",
+		"env_id":   "staging",
+		"code":     "fr-FR",
+	}, meta)
+	if diags.HasError() {
+		t.Fatalf("failed to read locale: %v", diags)
+	}
+	if d.Get("name") != "Français" {
+		t.Errorf("unexpected locale: %v", d.State().Attributes)
+	}
+}
+
+func TestResourceLocaleDelete_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+
+	tests := map[string]struct {
+		code    string
+		removed bool
+	}{
+		"existing": {code: "fr-FR"},
+		// Deleting a locale which was already removed in the web app succeeds.
+		"removed": {code: "de-DE", removed: true},
+	}
+
+	r := resourceContentfulLocale()
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var path string
+			testFakeResource(t, r, meta, []fakeResourceStep{
+				{
+					name:   "create",
+					config: map[string]interface{}{"space_id": "space", "env_id": "master", "name": tt.code, "code": tt.code},
+				},
+				{
+					name: "destroy",
+					remote: func(id string) {
+						path = "spaces/space/environments/master/locales/" + id
+						if tt.removed {
+							server.remove(path)
+						}
+					},
+					destroy: true,
+					check: func(t *testing.T, d *schema.ResourceData) {
+						if server.object(path) != nil {
+							t.Errorf("locale should be deleted: %v", server.object(path))
+						}
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,381 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	contentful "github.com/kitagry/contentful-go"
+)
+
+// rolePermissions maps the arguments of the permissions block to the keys of
+// the API.
+var rolePermissions = map[string]string{
+	"content_model":       "ContentModel",
+	"settings":            "Settings",
+	"content_delivery":    "ContentDelivery",
+	"environments":        "Environments",
+	"environment_aliases": "EnvironmentAliases",
+	"tags":                "Tags",
+}
+
+var rolePolicyActions = []string{
+	"read",
+	"create",
+	"update",
+	"delete",
+	"publish",
+	"unpublish",
+	"archive",
+	"unarchive",
+	roleActionsAll,
+}
+
+func resourceContentfulRole() *schema.Resource {
+	permissions := map[string]*schema.Schema{}
+	for name, key := range rolePermissions {
+		permissions[name] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The actions allowed for " + key + `, e.g. ["read"], or ["all"]`,
+		}
+	}
+
+	return &schema.Resource{
+		CreateContext: wrapRole(resourceCreateRole),
+		ReadContext:   wrapRole(resourceReadRole),
+		UpdateContext: wrapRole(resourceUpdateRole),
+		DeleteContext: wrapRole(resourceDeleteRole),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportRole,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"permissions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: permissions,
+				},
+			},
+			"policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Policies on entries and assets, evaluated in order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"effect": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"allow", "deny"}, false)),
+						},
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(rolePolicyActions, false)),
+							},
+						},
+						"constraint": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsJSON),
+							DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+								return jsonEqual(oldValue, newValue)
+							},
+							Description: `The JSON constraint on the entries or assets, e.g. {"equals": [{"doc": "sys.type"}, "Entry"]}`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func wrapRole(f func(ctx context.Context, d *schema.ResourceData, client ContentfulRoleClient) diag.Diagnostics) func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+		client := m.(*providerClient)
+		return f(ctx, d, client.roles)
+	}
+}
+
+func resourceCreateRole(ctx context.Context, d *schema.ResourceData, client ContentfulRoleClient) (diags diag.Diagnostics) {
+	spaceID := d.Get("space_id").(string)
+
+	role := &Role{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Permissions: transformPermissionsToContentfulFormat(d.Get("permissions").([]interface{})),
+		Policies:    transformPoliciesToContentfulFormat(d.Get("policy").([]interface{})),
+	}
+
+	err := client.Upsert(ctx, spaceID, role)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	err = setRoleProperties(d, role)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	d.SetId(role.Sys.ID)
+
+	return nil
+}
+
+func resourceUpdateRole(ctx context.Context, d *schema.ResourceData, client ContentfulRoleClient) (diags diag.Diagnostics) {
+	spaceID := d.Get("space_id").(string)
+	roleID := d.Id()
+	defer func() {
+		if diags.HasError() {
+			d.Partial(true)
+		}
+	}()
+
+	role, err := client.Get(ctx, spaceID, roleID)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	role.Name = d.Get("name").(string)
+	role.Description = d.Get("description").(string)
+	role.Permissions = transformPermissionsToContentfulFormat(d.Get("permissions").([]interface{}))
+	role.Policies = transformPoliciesToContentfulFormat(d.Get("policy").([]interface{}))
+
+	err = client.Upsert(ctx, spaceID, role)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	err = setRoleProperties(d, role)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	return nil
+}
+
+func resourceReadRole(ctx context.Context, d *schema.ResourceData, client ContentfulRoleClient) (diags diag.Diagnostics) {
+	spaceID := d.Get("space_id").(string)
+	roleID := d.Id()
+
+	role, err := client.Get(ctx, spaceID, roleID)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	err = setRoleProperties(d, role)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+	return
+}
+
+func resourceDeleteRole(ctx context.Context, d *schema.ResourceData, client ContentfulRoleClient) (diags diag.Diagnostics) {
+	spaceID := d.Get("space_id").(string)
+	roleID := d.Id()
+
+	role, err := client.Get(ctx, spaceID, roleID)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		return nil
+	}
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	err = client.Delete(ctx, spaceID, role)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		return nil
+	}
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	return
+}
+
+func resourceImportRole(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ids, err := parseImportID(d.Id(), "space_id", "role_id")
+	if err != nil {
+		return nil, err
+	}
+
+	client := m.(*providerClient)
+
+	role, err := client.roles.Get(ctx, ids[0], ids[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("space_id", ids[0]); err != nil {
+		return nil, err
+	}
+
+	if err := setRoleProperties(d, role); err != nil {
+		return nil, err
+	}
+
+	d.SetId(role.Sys.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setRoleProperties(d *schema.ResourceData, role *Role) (err error) {
+	err = d.Set("version", role.Sys.Version)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("name", role.Name)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("description", role.Description)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("permissions", transformPermissionsToTerraformFormat(role.Permissions))
+	if err != nil {
+		return err
+	}
+
+	currentPolicies, _ := d.Get("policy").([]interface{})
+	err = d.Set("policy", transformPoliciesToTerraformFormat(role.Policies, currentPolicies))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// transformPermissionsToContentfulFormat sends every permission, those which
+// aren't configured without any action.
+func transformPermissionsToContentfulFormat(permissionsTerraform []interface{}) map[string]RoleActions {
+	block := map[string]interface{}{}
+	if len(permissionsTerraform) > 0 && permissionsTerraform[0] != nil {
+		block = permissionsTerraform[0].(map[string]interface{})
+	}
+
+	permissions := make(map[string]RoleActions, len(rolePermissions))
+	for name, key := range rolePermissions {
+		actions, _ := block[name].([]interface{})
+		permissions[key] = transformActionsToContentfulFormat(actions)
+	}
+
+	return permissions
+}
+
+// transformPermissionsToTerraformFormat returns no block if no permission has
+// any action.
+func transformPermissionsToTerraformFormat(permissions map[string]RoleActions) []interface{} {
+	block := map[string]interface{}{}
+	empty := true
+	for name, key := range rolePermissions {
+		actions := permissions[key]
+		if len(actions) > 0 {
+			empty = false
+		}
+		block[name] = []string(actions)
+	}
+
+	if empty {
+		return []interface{}{}
+	}
+	return []interface{}{block}
+}
+
+func transformPoliciesToContentfulFormat(policiesTerraform []interface{}) []RolePolicy {
+	policies := make([]RolePolicy, 0, len(policiesTerraform))
+
+	for _, v := range policiesTerraform {
+		policy, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		p := RolePolicy{
+			Effect:  policy["effect"].(string),
+			Actions: transformActionsToContentfulFormat(policy["actions"].([]interface{})),
+		}
+		if constraint, _ := policy["constraint"].(string); constraint != "" {
+			p.Constraint = json.RawMessage(constraint)
+		}
+
+		policies = append(policies, p)
+	}
+
+	return policies
+}
+
+// transformPoliciesToTerraformFormat keeps the constraints of currentPolicies
+// which are equivalent to the returned ones.
+func transformPoliciesToTerraformFormat(policies []RolePolicy, currentPolicies []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(policies))
+
+	for i, policy := range policies {
+		constraint := string(policy.Constraint)
+		if i < len(currentPolicies) {
+			current, _ := currentPolicies[i].(map[string]interface{})
+			if currentConstraint, _ := current["constraint"].(string); jsonEqual(currentConstraint, constraint) {
+				constraint = currentConstraint
+			}
+		}
+
+		result = append(result, map[string]interface{}{
+			"effect":     policy.Effect,
+			"actions":    []string(policy.Actions),
+			"constraint": constraint,
+		})
+	}
+
+	return result
+}
+
+func transformActionsToContentfulFormat(actionsTerraform []interface{}) RoleActions {
+	actions := make(RoleActions, 0, len(actionsTerraform))
+	for _, v := range actionsTerraform {
+		actions = append(actions, v.(string))
+	}
+
+	return actions
+}
@@ -0,0 +1,166 @@
+package contentful
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestRoleActionsJSON(t *testing.T) {
+	tests := map[string]struct {
+		actions RoleActions
+		json    string
+	}{
+		"all": {
+			actions: RoleActions{"all"},
+			json:    `"all"`,
+		},
+		"list": {
+			actions: RoleActions{"read", "update"},
+			json:    `["read","update"]`,
+		},
+		"empty": {
+			actions: RoleActions{},
+			json:    `[]`,
+		},
+	}
+
+	for n, tt := range tests {
+		t.Run(n, func(t *testing.T) {
+			b, err := json.Marshal(tt.actions)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(b) != tt.json {
+				t.Errorf("expected %s, got %s", tt.json, b)
+			}
+
+			var got RoleActions
+			if err := json.Unmarshal([]byte(tt.json), &got); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.actions, got); diff != "" {
+				t.Errorf("actions diff (-expect, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceRole_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+
+	constraint := `{"and": [{"equals": [{"doc": "sys.type"}, "Entry"]}]}`
+	raw := map[string]interface{}{
+		"space_id":    "space",
+		"name":        "Editor",
+		"description": "Edits entries",
+		"permissions": []interface{}{
+			map[string]interface{}{
+				"content_model": []interface{}{"read"},
+				"tags":          []interface{}{"all"},
+			},
+		},
+		"policy": []interface{}{
+			map[string]interface{}{
+				"effect":     "allow",
+				"actions":    []interface{}{"all"},
+				"constraint": constraint,
+			},
+			map[string]interface{}{
+				"effect":  "deny",
+				"actions": []interface{}{"publish", "unpublish"},
+			},
+		},
+	}
+
+	r := resourceContentfulRole()
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:   "create",
+			config: raw,
+			check: func(t *testing.T, d *schema.ResourceData) {
+				path := "spaces/space/roles/" + d.Id()
+				expectPermissions := map[string]interface{}{
+					"ContentModel":       []interface{}{"read"},
+					"Settings":           []interface{}{},
+					"ContentDelivery":    []interface{}{},
+					"Environments":       []interface{}{},
+					"EnvironmentAliases": []interface{}{},
+					"Tags":               "all",
+				}
+				if diff := cmp.Diff(expectPermissions, server.object(path)["permissions"]); diff != "" {
+					t.Errorf("sent permissions diff (-expect, +got)\n%s", diff)
+				}
+				expectPolicies := []interface{}{
+					map[string]interface{}{
+						"effect":  "allow",
+						"actions": "all",
+						"constraint": map[string]interface{}{
+							"and": []interface{}{
+								map[string]interface{}{"equals": []interface{}{map[string]interface{}{"doc": "sys.type"}, "Entry"}},
+							},
+						},
+					},
+					map[string]interface{}{
+						"effect":  "deny",
+						"actions": []interface{}{"publish", "unpublish"},
+					},
+				}
+				if diff := cmp.Diff(expectPolicies, server.object(path)["policies"]); diff != "" {
+					t.Errorf("sent policies diff (-expect, +got)\n%s", diff)
+				}
+			},
+		},
+		{
+			name: "read",
+			check: func(t *testing.T, d *schema.ResourceData) {
+				checkFakeAttributes(t, d, map[string]interface{}{
+					"version":                     1,
+					"permissions.0.content_model": []interface{}{"read"},
+					"permissions.0.tags":          []interface{}{"all"},
+					"permissions.0.settings":      []interface{}{},
+					"policy.0.actions":            []interface{}{"all"},
+					"policy.0.constraint":         constraint,
+					"policy.1.actions":            []interface{}{"publish", "unpublish"},
+					"policy.1.constraint":         "",
+				})
+			},
+		},
+		{name: "plan", config: raw, planOnly: true},
+		// A role removed in the web app is removed from the state.
+		{
+			name: "read removed role",
+			remote: func(id string) {
+				server.remove("spaces/space/roles/" + id)
+			},
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if d.Id() != "" {
+					t.Errorf("removed role should be removed from the state, got ID %q", d.Id())
+				}
+			},
+		},
+	})
+
+	var id string
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:   "create",
+			config: raw,
+			check: func(t *testing.T, d *schema.ResourceData) {
+				id = d.Id()
+			},
+		},
+		{
+			name:    "destroy",
+			destroy: true,
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if server.object("spaces/space/roles/"+id) != nil {
+					t.Error("role should have been deleted")
+				}
+			},
+		},
+	})
+}
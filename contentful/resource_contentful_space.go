@@ -14,6 +14,9 @@ func resourceContentfulSpace() *schema.Resource {
 		ReadContext:   wrapSpace(resourceSpaceRead),
 		UpdateContext: wrapSpace(resourceSpaceUpdate),
 		DeleteContext: wrapSpace(resourceSpaceDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSpaceImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"version": {
@@ -36,7 +39,7 @@ func resourceContentfulSpace() *schema.Resource {
 
 func wrapSpace(f func(ctx context.Context, d *schema.ResourceData, client ContentfulSpaceClient) diag.Diagnostics) func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
-		client := m.(*contentful.Client)
+		client := m.(*providerClient)
 		return f(ctx, d, client.Spaces)
 	}
 }
@@ -67,7 +70,7 @@ func resourceSpaceCreate(ctx context.Context, d *schema.ResourceData, client Con
 func resourceSpaceRead(ctx context.Context, d *schema.ResourceData, client ContentfulSpaceClient) (diags diag.Diagnostics) {
 	spaceID := d.Id()
 
-	_, err := client.Get(ctx, spaceID)
+	space, err := client.Get(ctx, spaceID)
 	if _, ok := err.(contentful.NotFoundError); ok {
 		d.SetId("")
 		return nil
@@ -77,6 +80,12 @@ func resourceSpaceRead(ctx context.Context, d *schema.ResourceData, client Conte
 		return
 	}
 
+	err = updateSpaceProperties(d, space)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
 	return
 }
 
@@ -131,6 +140,29 @@ func resourceSpaceDelete(ctx context.Context, d *schema.ResourceData, client Con
 	return
 }
 
+func resourceSpaceImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*providerClient)
+
+	space, err := client.Spaces.Get(ctx, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := updateSpaceProperties(d, space); err != nil {
+		return nil, err
+	}
+
+	if space.DefaultLocale != "" {
+		if err := d.Set("default_locale", space.DefaultLocale); err != nil {
+			return nil, err
+		}
+	}
+
+	d.SetId(space.Sys.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func updateSpaceProperties(d *schema.ResourceData, space *contentful.Space) error {
 	err := d.Set("version", space.Sys.Version)
 	if err != nil {
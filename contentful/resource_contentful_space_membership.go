@@ -0,0 +1,286 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	contentful "github.com/kitagry/contentful-go"
+)
+
+func resourceContentfulSpaceMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: wrapSpaceMembership(resourceCreateSpaceMembership),
+		ReadContext:   wrapSpaceMembership(resourceReadSpaceMembership),
+		UpdateContext: wrapSpaceMembership(resourceUpdateSpaceMembership),
+		DeleteContext: wrapSpaceMembership(resourceDeleteSpaceMembership),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportSpaceMembership,
+		},
+		CustomizeDiff: validateMembershipRoles,
+
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"email", "user_id"},
+				Description:  "The email of the user. Users who aren't members of the organization are invited",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of a user of the organization of the provider",
+			},
+			"admin": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"role_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the roles of the user. Required unless admin is set",
+			},
+		},
+	}
+}
+
+func wrapSpaceMembership(f func(ctx context.Context, d *schema.ResourceData, client ContentfulSpaceMembershipClient) diag.Diagnostics) func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+		client := m.(*providerClient)
+		return f(ctx, d, client.spaceMemberships)
+	}
+}
+
+func resourceCreateSpaceMembership(ctx context.Context, d *schema.ResourceData, client ContentfulSpaceMembershipClient) (diags diag.Diagnostics) {
+	spaceID := d.Get("space_id").(string)
+
+	// Memberships are created by email, the ones of user IDs are looked up in
+	// the organization.
+	email := d.Get("email").(string)
+	if userID := d.Get("user_id").(string); userID != "" {
+		user, err := client.GetUser(ctx, userID)
+		if err != nil {
+			diags = append(diags, contentfulErrorToDiagnostic(err)...)
+			return
+		}
+		email = user.Email
+	}
+
+	membership := &SpaceMembership{
+		Admin: d.Get("admin").(bool),
+		Roles: transformRoleIDsToContentfulFormat(d.Get("role_ids").([]interface{})),
+		Email: email,
+	}
+
+	err := client.Upsert(ctx, spaceID, membership)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	err = d.Set("email", email)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	err = setSpaceMembershipProperties(d, membership)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	d.SetId(membership.Sys.ID)
+
+	return nil
+}
+
+func resourceUpdateSpaceMembership(ctx context.Context, d *schema.ResourceData, client ContentfulSpaceMembershipClient) (diags diag.Diagnostics) {
+	spaceID := d.Get("space_id").(string)
+	membershipID := d.Id()
+	defer func() {
+		if diags.HasError() {
+			d.Partial(true)
+		}
+	}()
+
+	membership, err := client.Get(ctx, spaceID, membershipID)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	membership.Admin = d.Get("admin").(bool)
+	membership.Roles = transformRoleIDsToContentfulFormat(d.Get("role_ids").([]interface{}))
+
+	err = client.Upsert(ctx, spaceID, membership)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	err = setSpaceMembershipProperties(d, membership)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	return nil
+}
+
+func resourceReadSpaceMembership(ctx context.Context, d *schema.ResourceData, client ContentfulSpaceMembershipClient) (diags diag.Diagnostics) {
+	spaceID := d.Get("space_id").(string)
+	membershipID := d.Id()
+
+	// Members removed in the web app are removed from the state.
+	membership, err := client.Get(ctx, spaceID, membershipID)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	err = setSpaceMembershipProperties(d, membership)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+	return
+}
+
+func resourceDeleteSpaceMembership(ctx context.Context, d *schema.ResourceData, client ContentfulSpaceMembershipClient) (diags diag.Diagnostics) {
+	spaceID := d.Get("space_id").(string)
+
+	err := client.Delete(ctx, spaceID, &SpaceMembership{Sys: &contentful.Sys{ID: d.Id()}})
+	if _, ok := err.(contentful.NotFoundError); ok {
+		return nil
+	}
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	return
+}
+
+func resourceImportSpaceMembership(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ids, err := parseImportID(d.Id(), "space_id", "membership_id")
+	if err != nil {
+		return nil, err
+	}
+
+	client := m.(*providerClient)
+
+	membership, err := client.spaceMemberships.Get(ctx, ids[0], ids[1])
+	if err != nil {
+		return nil, err
+	}
+
+	// The email isn't part of the membership.
+	if membership.User != nil && membership.User.Sys != nil {
+		user, err := client.spaceMemberships.GetUser(ctx, membership.User.Sys.ID)
+		if err != nil {
+			return nil, err
+		}
+		if err := d.Set("email", user.Email); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := d.Set("space_id", ids[0]); err != nil {
+		return nil, err
+	}
+
+	if err := setSpaceMembershipProperties(d, membership); err != nil {
+		return nil, err
+	}
+
+	d.SetId(membership.Sys.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setSpaceMembershipProperties(d *schema.ResourceData, membership *SpaceMembership) (err error) {
+	err = d.Set("version", membership.Sys.Version)
+	if err != nil {
+		return err
+	}
+
+	if membership.User != nil && membership.User.Sys != nil {
+		err = d.Set("user_id", membership.User.Sys.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = d.Set("admin", membership.Admin)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("role_ids", transformRoleIDsToTerraformFormat(membership.Roles))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMembershipRoles fails the plan of a membership which has neither
+// admin nor any roles, which Contentful rejects. Unknown values are left to
+// Contentful.
+func validateMembershipRoles(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("admin") || !d.NewValueKnown("role_ids") {
+		return nil
+	}
+	if !d.Get("admin").(bool) && len(d.Get("role_ids").([]interface{})) == 0 {
+		return errors.New("role_ids must not be empty unless admin is true")
+	}
+	return nil
+}
+
+func transformRoleIDsToContentfulFormat(roleIDsTerraform []interface{}) []contentful.Roles {
+	roles := make([]contentful.Roles, 0, len(roleIDsTerraform))
+	for _, v := range roleIDsTerraform {
+		roles = append(roles, contentful.Roles{
+			Sys: &contentful.Sys{
+				ID:       v.(string),
+				Type:     "Link",
+				LinkType: "Role",
+			},
+		})
+	}
+
+	return roles
+}
+
+func transformRoleIDsToTerraformFormat(roles []contentful.Roles) []string {
+	roleIDs := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if role.Sys != nil {
+			roleIDs = append(roleIDs, role.Sys.ID)
+		}
+	}
+
+	return roleIDs
+}
@@ -0,0 +1,149 @@
+package contentful
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceSpaceMembership_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+	server.addUser("user1", "user1@example.com")
+
+	editor := createFakeRole(t, meta, "space", "Editor")
+	author := createFakeRole(t, meta, "space", "Author")
+
+	r := resourceContentfulSpaceMembership()
+	raw := func(roleIDs ...interface{}) map[string]interface{} {
+		return map[string]interface{}{"space_id": "space", "user_id": "user1", "role_ids": roleIDs}
+	}
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		// Members need roles unless they are admins.
+		{
+			name:        "no roles",
+			config:      raw(),
+			expectError: regexp.MustCompile("role_ids must not be empty unless admin is true"),
+		},
+		{
+			name:   "create",
+			config: raw(editor),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				checkFakeAttributes(t, d, map[string]interface{}{
+					"email":    "user1@example.com",
+					"user_id":  "user1",
+					"admin":    false,
+					"role_ids": []interface{}{editor},
+				})
+			},
+		},
+		// Roles are changed in place.
+		{
+			name:   "update",
+			config: raw(editor, author),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if got := fakeLinkID(server.object("spaces/space/space_memberships/" + d.Id())["user"]); got != "user1" {
+					t.Errorf("update should keep the user, got %q", got)
+				}
+				if diff := cmp.Diff([]interface{}{editor, author}, d.Get("role_ids")); diff != "" {
+					t.Errorf("role_ids diff (-expect, +got)\n%s", diff)
+				}
+			},
+		},
+		// Members removed in the web app are removed from the state.
+		{
+			name: "read removed membership",
+			remote: func(id string) {
+				server.remove("spaces/space/space_memberships/" + id)
+			},
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if d.Id() != "" {
+					t.Errorf("removed membership should be removed from the state, got ID %q", d.Id())
+				}
+			},
+		},
+	})
+
+	// Users outside of the organization are invited by email.
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:   "invite",
+			config: map[string]interface{}{"space_id": "space", "email": "new@example.com", "admin": true},
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if d.Get("user_id") == "" || d.Get("email") != "new@example.com" {
+					t.Errorf("unexpected membership: %v", d.State().Attributes)
+				}
+			},
+		},
+	})
+
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:        "unknown user",
+			config:      map[string]interface{}{"space_id": "space", "user_id": "unknown", "admin": true},
+			expectError: regexp.MustCompile("can not be found"),
+		},
+	})
+}
+
+func TestResourceTeamSpaceMembership_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+	server.addTeam("team1")
+
+	editor := createFakeRole(t, meta, "space", "Editor")
+
+	r := resourceContentfulTeamSpaceMembership()
+	raw := func(admin bool) map[string]interface{} {
+		return map[string]interface{}{"space_id": "space", "team_id": "team1", "admin": admin, "role_ids": []interface{}{editor}}
+	}
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:   "create",
+			config: raw(false),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if got := fakeLinkID(server.object("spaces/space/team_space_memberships/" + d.Id())["team"]); got != "team1" {
+					t.Errorf("membership should belong to team1, got %q", got)
+				}
+			},
+		},
+		{
+			name:   "update",
+			config: raw(true),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if obj := server.object("spaces/space/team_space_memberships/" + d.Id()); obj["admin"] != true || d.Get("team_id") != "team1" {
+					t.Errorf("unexpected membership: %v", obj)
+				}
+			},
+		},
+		{
+			name: "read removed membership",
+			remote: func(id string) {
+				server.remove("spaces/space/team_space_memberships/" + id)
+			},
+			check: func(t *testing.T, d *schema.ResourceData) {
+				if d.Id() != "" {
+					t.Errorf("removed membership should be removed from the state, got ID %q", d.Id())
+				}
+			},
+		},
+	})
+
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:        "unknown team",
+			config:      map[string]interface{}{"space_id": "space", "team_id": "unknown", "admin": true},
+			expectError: regexp.MustCompile(`^team "unknown" doesn't exist in the organization$`),
+		},
+	})
+
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:        "no roles",
+			config:      map[string]interface{}{"space_id": "space", "team_id": "team1", "admin": false},
+			expectError: regexp.MustCompile("role_ids must not be empty unless admin is true"),
+		},
+	})
+}
@@ -0,0 +1,229 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	contentful "github.com/kitagry/contentful-go"
+)
+
+func resourceContentfulTeamSpaceMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: wrapTeamSpaceMembership(resourceCreateTeamSpaceMembership),
+		ReadContext:   wrapTeamSpaceMembership(resourceReadTeamSpaceMembership),
+		UpdateContext: wrapTeamSpaceMembership(resourceUpdateTeamSpaceMembership),
+		DeleteContext: wrapTeamSpaceMembership(resourceDeleteTeamSpaceMembership),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportTeamSpaceMembership,
+		},
+		CustomizeDiff: validateMembershipRoles,
+
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"space_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"team_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of a team of the organization of the provider",
+			},
+			"admin": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"role_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the roles of the team. Required unless admin is set",
+			},
+		},
+	}
+}
+
+func wrapTeamSpaceMembership(f func(ctx context.Context, d *schema.ResourceData, client ContentfulTeamSpaceMembershipClient) diag.Diagnostics) func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+		client := m.(*providerClient)
+		return f(ctx, d, client.teamSpaceMemberships)
+	}
+}
+
+func resourceCreateTeamSpaceMembership(ctx context.Context, d *schema.ResourceData, client ContentfulTeamSpaceMembershipClient) (diags diag.Diagnostics) {
+	spaceID := d.Get("space_id").(string)
+	teamID := d.Get("team_id").(string)
+
+	// The error for unknown teams of the space endpoint doesn't name the team.
+	_, err := client.GetTeam(ctx, teamID)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		diags = append(diags, diag.Errorf("team %q doesn't exist in the organization", teamID)...)
+		return
+	}
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	membership := &TeamSpaceMembership{
+		Admin: d.Get("admin").(bool),
+		Roles: transformRoleIDsToContentfulFormat(d.Get("role_ids").([]interface{})),
+		Team: &contentful.Member{
+			Sys: &contentful.Sys{
+				ID:       teamID,
+				Type:     "Link",
+				LinkType: "Team",
+			},
+		},
+	}
+
+	err = client.Upsert(ctx, spaceID, membership)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	err = setTeamSpaceMembershipProperties(d, membership)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	d.SetId(membership.Sys.ID)
+
+	return nil
+}
+
+func resourceUpdateTeamSpaceMembership(ctx context.Context, d *schema.ResourceData, client ContentfulTeamSpaceMembershipClient) (diags diag.Diagnostics) {
+	spaceID := d.Get("space_id").(string)
+	membershipID := d.Id()
+	defer func() {
+		if diags.HasError() {
+			d.Partial(true)
+		}
+	}()
+
+	membership, err := client.Get(ctx, spaceID, membershipID)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	membership.Admin = d.Get("admin").(bool)
+	membership.Roles = transformRoleIDsToContentfulFormat(d.Get("role_ids").([]interface{}))
+
+	err = client.Upsert(ctx, spaceID, membership)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	err = setTeamSpaceMembershipProperties(d, membership)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	return nil
+}
+
+func resourceReadTeamSpaceMembership(ctx context.Context, d *schema.ResourceData, client ContentfulTeamSpaceMembershipClient) (diags diag.Diagnostics) {
+	spaceID := d.Get("space_id").(string)
+	membershipID := d.Id()
+
+	// Teams removed in the web app are removed from the state.
+	membership, err := client.Get(ctx, spaceID, membershipID)
+	if _, ok := err.(contentful.NotFoundError); ok {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	err = setTeamSpaceMembershipProperties(d, membership)
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+	return
+}
+
+func resourceDeleteTeamSpaceMembership(ctx context.Context, d *schema.ResourceData, client ContentfulTeamSpaceMembershipClient) (diags diag.Diagnostics) {
+	spaceID := d.Get("space_id").(string)
+
+	err := client.Delete(ctx, spaceID, &TeamSpaceMembership{Sys: &contentful.Sys{ID: d.Id()}})
+	if _, ok := err.(contentful.NotFoundError); ok {
+		return nil
+	}
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	return
+}
+
+func resourceImportTeamSpaceMembership(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ids, err := parseImportID(d.Id(), "space_id", "membership_id")
+	if err != nil {
+		return nil, err
+	}
+
+	client := m.(*providerClient)
+
+	membership, err := client.teamSpaceMemberships.Get(ctx, ids[0], ids[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("space_id", ids[0]); err != nil {
+		return nil, err
+	}
+
+	if err := setTeamSpaceMembershipProperties(d, membership); err != nil {
+		return nil, err
+	}
+
+	d.SetId(membership.Sys.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setTeamSpaceMembershipProperties(d *schema.ResourceData, membership *TeamSpaceMembership) (err error) {
+	err = d.Set("version", membership.Sys.Version)
+	if err != nil {
+		return err
+	}
+
+	if membership.Team == nil || membership.Team.Sys == nil {
+		return fmt.Errorf("team space membership %s has no team", membership.Sys.ID)
+	}
+
+	err = d.Set("team_id", membership.Team.Sys.ID)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("admin", membership.Admin)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("role_ids", transformRoleIDsToTerraformFormat(membership.Roles))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -2,9 +2,13 @@ package contentful
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	contentful "github.com/kitagry/contentful-go"
 )
 
@@ -14,6 +18,10 @@ func resourceContentfulWebhook() *schema.Resource {
 		ReadContext:   wrapWebhook(resourceReadWebhook),
 		UpdateContext: wrapWebhook(resourceUpdateWebhook),
 		DeleteContext: wrapWebhook(resourceDeleteWebhook),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImportWebhook,
+		},
+		CustomizeDiff: validateWebhookFilters,
 
 		Schema: map[string]*schema.Schema{
 			"version": {
@@ -39,13 +47,23 @@ func resourceContentfulWebhook() *schema.Resource {
 				Default:  "",
 			},
 			"http_basic_auth_password": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "",
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				Default:   "",
 			},
 			"headers": {
-				Type:     schema.TypeMap,
-				Optional: true,
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			"secret_headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Headers whose values are stored as secrets. Contentful never returns their values, so only changes of the keys are detected",
 			},
 			"topics": {
 				Type: schema.TypeList,
@@ -55,30 +73,126 @@ func resourceContentfulWebhook() *schema.Resource {
 				MinItems: 1,
 				Required: true,
 			},
+			"filter": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Conditions which have to match for the webhook to be called",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"doc": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(webhookFilterDocs, false)),
+							Description:      "The property to filter on: sys.environment.sys.id, sys.contentType.sys.id or sys.id",
+						},
+						"equals": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"in": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"regexp": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A JavaScript regular expression",
+						},
+						"not": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Negates the condition",
+						},
+					},
+				},
+			},
+			"transformation": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"method": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"POST", "GET", "PUT", "PATCH", "DELETE"}, false)),
+						},
+						"content_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(webhookContentTypes, false)),
+						},
+						"include_content_length": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"body": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsJSON),
+							DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+								return jsonEqual(oldValue, newValue)
+							},
+							Description: "The JSON body of the request. Values can reference the payload, e.g. \"{ /payload/sys/id }\"",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func wrapWebhook(f func(ctx context.Context, d *schema.ResourceData, client ContentfulWebhookClient) diag.Diagnostics) func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
-		client := m.(*contentful.Client)
-		return f(ctx, d, client.Webhooks)
+		client := m.(*providerClient)
+		return f(ctx, d, client.webhooks)
 	}
 }
 
+var webhookFilterDocs = []string{
+	"sys.environment.sys.id",
+	"sys.contentType.sys.id",
+	"sys.id",
+}
+
+var webhookContentTypes = []string{
+	"application/vnd.contentful.management.v1+json",
+	"application/vnd.contentful.management.v1+json; charset=utf-8",
+	"application/json",
+	"application/json; charset=utf-8",
+	"application/x-www-form-urlencoded",
+	"application/x-www-form-urlencoded; charset=utf-8",
+}
+
 func resourceCreateWebhook(ctx context.Context, d *schema.ResourceData, client ContentfulWebhookClient) (diags diag.Diagnostics) {
 	spaceID := d.Get("space_id").(string)
 
-	webhook := &contentful.Webhook{
+	headers, err := transformHeadersToContentfulFormat(d.Get("headers"), d.Get("secret_headers"))
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	filters, err := transformFiltersToContentfulFormat(d.Get("filter").([]interface{}))
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+
+	webhook := &Webhook{
 		Name:              d.Get("name").(string),
 		URL:               d.Get("url").(string),
 		Topics:            transformTopicsToContentfulFormat(d.Get("topics").([]interface{})),
-		Headers:           transformHeadersToContentfulFormat(d.Get("headers")),
+		Headers:           headers,
 		HTTPBasicUsername: d.Get("http_basic_auth_username").(string),
 		HTTPBasicPassword: d.Get("http_basic_auth_password").(string),
+		Filters:           filters,
+		Transformation:    transformTransformationToContentfulFormat(d.Get("transformation").([]interface{})),
 	}
 
-	err := client.Upsert(ctx, spaceID, webhook)
+	err = client.Upsert(ctx, spaceID, webhook)
 	if err != nil {
 		diags = append(diags, contentfulErrorToDiagnostic(err)...)
 		return
@@ -113,9 +227,19 @@ func resourceUpdateWebhook(ctx context.Context, d *schema.ResourceData, client C
 	webhook.Name = d.Get("name").(string)
 	webhook.URL = d.Get("url").(string)
 	webhook.Topics = transformTopicsToContentfulFormat(d.Get("topics").([]interface{}))
-	webhook.Headers = transformHeadersToContentfulFormat(d.Get("headers"))
+	webhook.Headers, err = transformHeadersToContentfulFormat(d.Get("headers"), d.Get("secret_headers"))
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
 	webhook.HTTPBasicUsername = d.Get("http_basic_auth_username").(string)
 	webhook.HTTPBasicPassword = d.Get("http_basic_auth_password").(string)
+	webhook.Filters, err = transformFiltersToContentfulFormat(d.Get("filter").([]interface{}))
+	if err != nil {
+		diags = append(diags, contentfulErrorToDiagnostic(err)...)
+		return
+	}
+	webhook.Transformation = transformTransformationToContentfulFormat(d.Get("transformation").([]interface{}))
 
 	err = client.Upsert(ctx, spaceID, webhook)
 	if err != nil {
@@ -179,9 +303,40 @@ func resourceDeleteWebhook(ctx context.Context, d *schema.ResourceData, client C
 	return
 }
 
-func setWebhookProperties(d *schema.ResourceData, webhook *contentful.Webhook) (err error) {
+func resourceImportWebhook(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	ids, err := parseImportID(d.Id(), "space_id", "webhook_id")
+	if err != nil {
+		return nil, err
+	}
+
+	client := m.(*providerClient)
+
+	webhook, err := client.webhooks.Get(ctx, ids[0], ids[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setWebhookProperties(d, webhook); err != nil {
+		return nil, err
+	}
+
+	d.SetId(webhook.Sys.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setWebhookProperties(d *schema.ResourceData, webhook *Webhook) (err error) {
+	// The values of secret headers aren't returned, the known ones are kept.
+	currentSecretHeaders, _ := d.Get("secret_headers").(map[string]interface{})
+
 	headers := make(map[string]string)
+	secretHeaders := make(map[string]string)
 	for _, entry := range webhook.Headers {
+		if entry.Secret {
+			value, _ := currentSecretHeaders[entry.Key].(string)
+			secretHeaders[entry.Key] = value
+			continue
+		}
 		headers[entry.Key] = entry.Value
 	}
 
@@ -190,6 +345,11 @@ func setWebhookProperties(d *schema.ResourceData, webhook *contentful.Webhook) (
 		return err
 	}
 
+	err = d.Set("secret_headers", secretHeaders)
+	if err != nil {
+		return err
+	}
+
 	err = d.Set("space_id", webhook.Sys.Space.Sys.ID)
 	if err != nil {
 		return err
@@ -220,20 +380,46 @@ func setWebhookProperties(d *schema.ResourceData, webhook *contentful.Webhook) (
 		return err
 	}
 
+	err = d.Set("filter", transformFiltersToTerraformFormat(webhook.Filters))
+	if err != nil {
+		return err
+	}
+
+	currentBody, _ := d.Get("transformation.0.body").(string)
+	err = d.Set("transformation", transformTransformationToTerraformFormat(webhook.Transformation, currentBody))
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func transformHeadersToContentfulFormat(headersTerraform interface{}) []*contentful.WebhookHeader {
-	var headers []*contentful.WebhookHeader
+func transformHeadersToContentfulFormat(headersTerraform, secretHeadersTerraform interface{}) ([]WebhookHeader, error) {
+	headers := make([]WebhookHeader, 0)
 
 	for k, v := range headersTerraform.(map[string]interface{}) {
-		headers = append(headers, &contentful.WebhookHeader{
+		headers = append(headers, WebhookHeader{
 			Key:   k,
 			Value: v.(string),
 		})
 	}
 
-	return headers
+	for k, v := range secretHeadersTerraform.(map[string]interface{}) {
+		if _, ok := headersTerraform.(map[string]interface{})[k]; ok {
+			return nil, fmt.Errorf("header %q is set in both headers and secret_headers", k)
+		}
+		headers = append(headers, WebhookHeader{
+			Key:    k,
+			Value:  v.(string),
+			Secret: true,
+		})
+	}
+
+	sort.Slice(headers, func(i, j int) bool {
+		return headers[i].Key < headers[j].Key
+	})
+
+	return headers, nil
 }
 
 func transformTopicsToContentfulFormat(topicsTerraform []interface{}) []string {
@@ -245,3 +431,143 @@ func transformTopicsToContentfulFormat(topicsTerraform []interface{}) []string {
 
 	return topics
 }
+
+// validateWebhookFilters checks that every filter block sets exactly one of
+// equals, in and regexp.
+func validateWebhookFilters(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("filter") {
+		return nil
+	}
+
+	_, err := transformFiltersToContentfulFormat(d.Get("filter").([]interface{}))
+	return err
+}
+
+func transformFiltersToContentfulFormat(filtersTerraform []interface{}) ([]WebhookFilter, error) {
+	var filters []WebhookFilter
+
+	for i, v := range filtersTerraform {
+		filter, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		doc := map[string]interface{}{"doc": filter["doc"]}
+
+		var operators []string
+		condition := WebhookFilter{}
+		if equals, _ := filter["equals"].(string); equals != "" {
+			operators = append(operators, "equals")
+			condition["equals"] = []interface{}{doc, equals}
+		}
+		if in, _ := filter["in"].([]interface{}); len(in) > 0 {
+			operators = append(operators, "in")
+			condition["in"] = []interface{}{doc, in}
+		}
+		if pattern, _ := filter["regexp"].(string); pattern != "" {
+			operators = append(operators, "regexp")
+			condition["regexp"] = []interface{}{doc, map[string]interface{}{"pattern": pattern}}
+		}
+		if len(operators) != 1 {
+			return nil, fmt.Errorf("filter.%d: exactly one of equals, in and regexp has to be set, got %d", i, len(operators))
+		}
+
+		if not, _ := filter["not"].(bool); not {
+			condition = WebhookFilter{"not": condition}
+		}
+		filters = append(filters, condition)
+	}
+
+	return filters, nil
+}
+
+func transformFiltersToTerraformFormat(filters []WebhookFilter) []interface{} {
+	result := make([]interface{}, 0, len(filters))
+
+	for _, filter := range filters {
+		condition := map[string]interface{}(filter)
+		not := false
+		if inner, ok := condition["not"].(map[string]interface{}); ok {
+			condition = inner
+			not = true
+		}
+
+		for _, operator := range []string{"equals", "in", "regexp"} {
+			args, ok := condition[operator].([]interface{})
+			if !ok || len(args) != 2 {
+				continue
+			}
+
+			docMap, _ := args[0].(map[string]interface{})
+			doc, _ := docMap["doc"].(string)
+			block := map[string]interface{}{
+				"doc": doc,
+				"not": not,
+			}
+
+			switch operator {
+			case "equals":
+				block["equals"], _ = args[1].(string)
+			case "in":
+				block["in"], _ = args[1].([]interface{})
+			case "regexp":
+				pattern, _ := args[1].(map[string]interface{})
+				block["regexp"], _ = pattern["pattern"].(string)
+			}
+
+			result = append(result, block)
+			break
+		}
+	}
+
+	return result
+}
+
+func transformTransformationToContentfulFormat(transformationTerraform []interface{}) *WebhookTransformation {
+	if len(transformationTerraform) == 0 || transformationTerraform[0] == nil {
+		return nil
+	}
+
+	t := transformationTerraform[0].(map[string]interface{})
+	transformation := &WebhookTransformation{
+		Method:      t["method"].(string),
+		ContentType: t["content_type"].(string),
+	}
+
+	if includeContentLength, _ := t["include_content_length"].(bool); includeContentLength {
+		transformation.IncludeContentLength = &includeContentLength
+	}
+
+	if body, _ := t["body"].(string); body != "" {
+		transformation.Body = json.RawMessage(body)
+	}
+
+	return transformation
+}
+
+// transformTransformationToTerraformFormat keeps currentBody if it is
+// equivalent to the returned body.
+func transformTransformationToTerraformFormat(transformation *WebhookTransformation, currentBody string) []interface{} {
+	if transformation == nil {
+		return []interface{}{}
+	}
+
+	body := string(transformation.Body)
+	if jsonEqual(currentBody, body) {
+		body = currentBody
+	}
+
+	includeContentLength := false
+	if transformation.IncludeContentLength != nil {
+		includeContentLength = *transformation.IncludeContentLength
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"method":                 transformation.Method,
+			"content_type":           transformation.ContentType,
+			"include_content_length": includeContentLength,
+			"body":                   body,
+		},
+	}
+}
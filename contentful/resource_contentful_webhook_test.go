@@ -0,0 +1,191 @@
+package contentful
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceWebhookSchemaSensitive(t *testing.T) {
+	r := resourceContentfulWebhook()
+	for _, name := range []string{"http_basic_auth_password", "headers", "secret_headers"} {
+		if !r.Schema[name].Sensitive {
+			t.Errorf("%s should be sensitive", name)
+		}
+	}
+}
+
+func TestResourceWebhookSecretHeaders_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+
+	raw := func(headers, secretHeaders map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"space_id":       "space",
+			"name":           "webhook",
+			"url":            "https://www.example.com/webhook",
+			"topics":         []interface{}{"Entry.publish"},
+			"headers":        headers,
+			"secret_headers": secretHeaders,
+		}
+	}
+
+	r := resourceContentfulWebhook()
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:   "create",
+			config: raw(map[string]interface{}{"X-Public": "public"}, map[string]interface{}{"Authorization": "Bearer secret"}),
+			check: func(t *testing.T, d *schema.ResourceData) {
+				expectSent := []interface{}{
+					map[string]interface{}{"key": "Authorization", "value": "Bearer secret", "secret": true},
+					map[string]interface{}{"key": "X-Public", "value": "public"},
+				}
+				if diff := cmp.Diff(expectSent, server.object("spaces/space/webhook_definitions/" + d.Id())["headers"]); diff != "" {
+					t.Errorf("sent headers diff (-expect, +got)\n%s", diff)
+				}
+			},
+		},
+		// The secret value isn't returned, the configured one is kept.
+		{
+			name: "read",
+			check: func(t *testing.T, d *schema.ResourceData) {
+				checkFakeAttributes(t, d, map[string]interface{}{
+					"headers":        map[string]interface{}{"X-Public": "public"},
+					"secret_headers": map[string]interface{}{"Authorization": "Bearer secret"},
+				})
+			},
+		},
+		// A secret header added in the web app shows up without its value.
+		{
+			name: "read secret header",
+			remote: func(id string) {
+				server.update("spaces/space/webhook_definitions/"+id, func(obj map[string]interface{}) {
+					obj["headers"] = append(obj["headers"].([]interface{}), map[string]interface{}{"key": "X-Token", "value": "token", "secret": true})
+				})
+			},
+			check: func(t *testing.T, d *schema.ResourceData) {
+				checkFakeAttributes(t, d, map[string]interface{}{
+					"secret_headers": map[string]interface{}{"Authorization": "Bearer secret", "X-Token": ""},
+				})
+			},
+		},
+	})
+
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:        "duplicated header",
+			config:      raw(map[string]interface{}{"Authorization": "a"}, map[string]interface{}{"Authorization": "b"}),
+			expectError: regexp.MustCompile(`header "Authorization" is set in both headers and secret_headers`),
+		},
+	})
+}
+
+func TestTransformFilters(t *testing.T) {
+	tests := map[string]struct {
+		filters []interface{}
+
+		expect    []WebhookFilter
+		expectErr bool
+	}{
+		"operators": {
+			filters: []interface{}{
+				map[string]interface{}{"doc": "sys.environment.sys.id", "equals": "master", "in": []interface{}{}, "regexp": "", "not": false},
+				map[string]interface{}{"doc": "sys.contentType.sys.id", "equals": "", "in": []interface{}{"post", "page"}, "regexp": "", "not": false},
+				map[string]interface{}{"doc": "sys.id", "equals": "", "in": []interface{}{}, "regexp": "^test-", "not": true},
+			},
+			expect: []WebhookFilter{
+				{"equals": []interface{}{map[string]interface{}{"doc": "sys.environment.sys.id"}, "master"}},
+				{"in": []interface{}{map[string]interface{}{"doc": "sys.contentType.sys.id"}, []interface{}{"post", "page"}}},
+				{"not": WebhookFilter{"regexp": []interface{}{map[string]interface{}{"doc": "sys.id"}, map[string]interface{}{"pattern": "^test-"}}}},
+			},
+		},
+		"no operator": {
+			filters: []interface{}{
+				map[string]interface{}{"doc": "sys.id", "equals": "", "in": []interface{}{}, "regexp": "", "not": false},
+			},
+			expectErr: true,
+		},
+		"several operators": {
+			filters: []interface{}{
+				map[string]interface{}{"doc": "sys.id", "equals": "a", "in": []interface{}{"b"}, "regexp": "", "not": false},
+			},
+			expectErr: true,
+		},
+	}
+
+	for n, tt := range tests {
+		t.Run(n, func(t *testing.T) {
+			got, err := transformFiltersToContentfulFormat(tt.filters)
+			if tt.expectErr != (err != nil) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.expect, got); diff != "" {
+				t.Errorf("filters diff (-expect, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceWebhookFilterAndTransformation_FakeServer(t *testing.T) {
+	server, meta := newFakeClient(t)
+	server.addSpace("space")
+
+	body := `{ "id": "{ /payload/sys/id }" }`
+	raw := map[string]interface{}{
+		"space_id": "space",
+		"name":     "webhook",
+		"url":      "https://www.example.com/webhook",
+		"topics":   []interface{}{"Entry.publish"},
+		"filter": []interface{}{
+			map[string]interface{}{"doc": "sys.environment.sys.id", "equals": "master"},
+			map[string]interface{}{"doc": "sys.contentType.sys.id", "in": []interface{}{"post", "page"}, "not": true},
+		},
+		"transformation": []interface{}{
+			map[string]interface{}{
+				"method":                 "PUT",
+				"content_type":           "application/json",
+				"include_content_length": true,
+				"body":                   body,
+			},
+		},
+	}
+
+	r := resourceContentfulWebhook()
+	testFakeResource(t, r, meta, []fakeResourceStep{
+		{
+			name:   "create",
+			config: raw,
+			check: func(t *testing.T, d *schema.ResourceData) {
+				expectTransformation := map[string]interface{}{
+					"method":               "PUT",
+					"contentType":          "application/json",
+					"includeContentLength": true,
+					"body":                 map[string]interface{}{"id": "{ /payload/sys/id }"},
+				}
+				if diff := cmp.Diff(expectTransformation, server.object("spaces/space/webhook_definitions/" + d.Id())["transformation"]); diff != "" {
+					t.Errorf("sent transformation diff (-expect, +got)\n%s", diff)
+				}
+			},
+		},
+		{
+			name: "read",
+			check: func(t *testing.T, d *schema.ResourceData) {
+				checkFakeAttributes(t, d, map[string]interface{}{
+					"filter.#":                2,
+					"filter.0.doc":            "sys.environment.sys.id",
+					"filter.0.equals":         "master",
+					"filter.0.not":            false,
+					"filter.1.doc":            "sys.contentType.sys.id",
+					"filter.1.in":             []interface{}{"post", "page"},
+					"filter.1.not":            true,
+					"transformation.0.method": "PUT",
+					"transformation.0.body":   body,
+					"transformation.0.include_content_length": true,
+				})
+			},
+		},
+		{name: "plan", config: raw, planOnly: true},
+	})
+}
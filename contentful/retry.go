@@ -0,0 +1,209 @@
+package contentful
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	rateLimitResetHeader = "X-Contentful-RateLimit-Reset"
+
+	defaultMaxRetries = 5
+	minRetryBackoff   = time.Second
+	maxRetryBackoff   = 30 * time.Second
+)
+
+// retryTransport retries requests which failed because of the rate limit, and
+// idempotent requests which failed with a server error. A POST which failed with
+// a server error may still have been processed, so sending it again could
+// create a duplicate. It optionally limits the number of requests per second.
+// It sits below every client of the provider, so all API calls share the same
+// retry and rate limit behavior.
+type retryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	limiter    *rateLimiter
+
+	// timeout limits every attempt, including reading the response body, but
+	// not the backoff between attempts. 0 means no timeout.
+	timeout time.Duration
+
+	// sleep waits for the given duration. It is replaced in tests.
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func newRetryTransport(base http.RoundTripper, maxRetries int, maxRatePerSecond int, timeout time.Duration) *retryTransport {
+	t := &retryTransport{
+		base:       base,
+		maxRetries: maxRetries,
+		timeout:    timeout,
+		sleep:      sleepContext,
+	}
+	if maxRatePerSecond > 0 {
+		t.limiter = &rateLimiter{interval: time.Second / time.Duration(maxRatePerSecond)}
+	}
+	return t
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		if t.limiter != nil {
+			if err := t.limiter.wait(ctx, t.sleep); err != nil {
+				return nil, err
+			}
+		}
+
+		r, err := rewindRequest(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := t.roundTripAttempt(r)
+		if err != nil || !isRetryableResponse(req.Method, res.StatusCode) {
+			return res, err
+		}
+
+		// The request can't be sent again if its body can't be rewound.
+		if attempt >= t.maxRetries || (req.Body != nil && req.GetBody == nil) {
+			// contentful-go retries rate limited requests itself as long as
+			// the reset header is present, without any limit and without
+			// rewinding the body. Strip the header so that the error is
+			// returned instead.
+			res.Header.Del(rateLimitResetHeader)
+			return res, nil
+		}
+
+		backoff := retryBackoff(res, attempt)
+
+		// Drain the body so the connection can be reused.
+		_, _ = io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+
+		if err := t.sleep(ctx, backoff); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// roundTripAttempt sends a single attempt with its own timeout. The timeout is
+// released once the response body is closed.
+func (t *retryTransport) roundTripAttempt(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	res, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	res.Body = &cancelBody{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+// cancelBody cancels the context of its request when it is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// rewindRequest returns the request to send for the given attempt. Retries get
+// a copy of the request with a fresh body.
+func rewindRequest(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	r := req.Clone(req.Context())
+	r.Body = body
+	return r, nil
+}
+
+func isRetryableResponse(method string, code int) bool {
+	if code == http.StatusTooManyRequests {
+		return true
+	}
+	return code >= http.StatusInternalServerError && isIdempotentMethod(method)
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryBackoff returns how long to wait before the next attempt. Rate limited
+// responses tell how many seconds are left until the limit resets, otherwise
+// the wait doubles with every attempt.
+func retryBackoff(res *http.Response, attempt int) time.Duration {
+	if res.StatusCode == http.StatusTooManyRequests {
+		if seconds, err := strconv.Atoi(res.Header.Get(rateLimitResetHeader)); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+
+	backoff := minRetryBackoff
+	for i := 0; i < attempt && backoff < maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxRetryBackoff {
+		backoff = maxRetryBackoff
+	}
+	return backoff
+}
+
+// rateLimiter spaces requests evenly so that no more than one request is sent
+// per interval.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func (l *rateLimiter) wait(ctx context.Context, sleep func(context.Context, time.Duration) error) error {
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	return sleep(ctx, delay)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,279 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	contentful "github.com/kitagry/contentful-go"
+)
+
+type fakeResponse struct {
+	status int
+	reset  string
+}
+
+// newRetryTestServer returns a server which answers with the given responses in
+// order, followed by 200 once they run out. It records the body of every request.
+func newRetryTestServer(t *testing.T, responses []fakeResponse) (*httptest.Server, func() []string) {
+	t.Helper()
+
+	var (
+		mu     sync.Mutex
+		bodies []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		mu.Lock()
+		bodies = append(bodies, string(body))
+		i := len(bodies) - 1
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if i >= len(responses) {
+			fmt.Fprint(w, `{"sys":{"id":"space-id","type":"Space","version":2},"name":"space"}`)
+			return
+		}
+
+		res := responses[i]
+		if res.reset != "" {
+			w.Header().Set(rateLimitResetHeader, res.reset)
+		}
+		w.WriteHeader(res.status)
+		if res.status == http.StatusTooManyRequests {
+			fmt.Fprint(w, `{"sys":{"type":"Error","id":"RateLimitExceeded"},"message":"You have exceeded the rate limit"}`)
+		} else {
+			fmt.Fprint(w, `{"sys":{"type":"Error","id":"ServerError"},"message":"Internal server error"}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return bodies
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := map[string]struct {
+		responses  []fakeResponse
+		maxRetries int
+		// create sends a POST instead of a PUT.
+		create      bool
+		wantErr     bool
+		wantBackoff []time.Duration
+	}{
+		"success": {
+			maxRetries:  3,
+			wantBackoff: nil,
+		},
+		"rate limit reset": {
+			responses: []fakeResponse{
+				{status: http.StatusTooManyRequests, reset: "2"},
+			},
+			maxRetries:  3,
+			wantBackoff: []time.Duration{2 * time.Second},
+		},
+		"exponential backoff": {
+			responses: []fakeResponse{
+				{status: http.StatusTooManyRequests},
+				{status: http.StatusInternalServerError},
+				{status: http.StatusBadGateway},
+			},
+			maxRetries:  3,
+			wantBackoff: []time.Duration{time.Second, 2 * time.Second, 4 * time.Second},
+		},
+		"retries exhausted": {
+			responses: []fakeResponse{
+				{status: http.StatusTooManyRequests, reset: "1"},
+				{status: http.StatusTooManyRequests, reset: "1"},
+				{status: http.StatusTooManyRequests, reset: "1"},
+			},
+			maxRetries:  2,
+			wantErr:     true,
+			wantBackoff: []time.Duration{time.Second, time.Second},
+		},
+		"retries disabled": {
+			responses: []fakeResponse{
+				{status: http.StatusServiceUnavailable},
+			},
+			maxRetries:  0,
+			wantErr:     true,
+			wantBackoff: nil,
+		},
+		"server error on create": {
+			responses: []fakeResponse{
+				{status: http.StatusInternalServerError},
+			},
+			maxRetries:  3,
+			create:      true,
+			wantErr:     true,
+			wantBackoff: nil,
+		},
+		"rate limit on create": {
+			responses: []fakeResponse{
+				{status: http.StatusTooManyRequests, reset: "1"},
+			},
+			maxRetries:  3,
+			create:      true,
+			wantBackoff: []time.Duration{time.Second},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server, bodies := newRetryTestServer(t, tt.responses)
+
+			var backoff []time.Duration
+			transport := newRetryTransport(http.DefaultTransport, tt.maxRetries, 0, 0)
+			transport.sleep = func(ctx context.Context, d time.Duration) error {
+				backoff = append(backoff, d)
+				return nil
+			}
+
+			client := contentful.NewCMA("token")
+			client.BaseURL = server.URL
+			client.SetHTTPClient(&http.Client{Transport: transport})
+
+			space := &contentful.Space{
+				Sys:  &contentful.Sys{ID: "space-id", Version: 1, CreatedAt: "2020-01-01T00:00:00Z"},
+				Name: "space",
+			}
+			if tt.create {
+				space.Sys = nil
+			}
+			err := client.Spaces.Upsert(context.Background(), space)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.wantBackoff, backoff); diff != "" {
+				t.Errorf("backoff mismatch (-want +got):\n%s", diff)
+			}
+
+			// Every attempt has to send the full body again.
+			got := bodies()
+			if len(got) != len(backoff)+1 {
+				t.Fatalf("expected %d requests, got %d", len(backoff)+1, len(got))
+			}
+			for i, body := range got {
+				if !strings.Contains(body, `"name":"space"`) {
+					t.Errorf("request %d was sent without its body: %q", i, body)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryTransportTimeout(t *testing.T) {
+	// The first attempt is rate limited, the second one answers after delay.
+	newServer := func(delay time.Duration) *httptest.Server {
+		var (
+			mu       sync.Mutex
+			attempts int
+		)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			attempts++
+			attempt := attempts
+			mu.Unlock()
+
+			if attempt == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			select {
+			case <-time.After(delay):
+			case <-r.Context().Done():
+			}
+			fmt.Fprint(w, "ok")
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+
+	tests := map[string]struct {
+		delay   time.Duration
+		wantErr bool
+	}{
+		// The backoff before the second attempt is longer than the timeout,
+		// but doesn't count against it.
+		"backoff is not limited": {
+			delay: 0,
+		},
+		"slow attempt times out": {
+			delay:   time.Second,
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := newServer(tt.delay)
+
+			transport := newRetryTransport(http.DefaultTransport, 1, 0, 100*time.Millisecond)
+			transport.sleep = func(ctx context.Context, d time.Duration) error {
+				return sleepContext(ctx, 200*time.Millisecond)
+			}
+
+			client := &http.Client{Transport: transport}
+			res, err := client.Get(server.URL)
+			if tt.wantErr {
+				if err == nil {
+					res.Body.Close()
+					t.Fatal("expected the attempt to time out")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer res.Body.Close()
+
+			// The body can be read after RoundTrip returned.
+			body, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(body) != "ok" {
+				t.Errorf("unexpected body: %q", body)
+			}
+		})
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	var delays []time.Duration
+	sleep := func(ctx context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+
+	limiter := &rateLimiter{interval: time.Minute}
+	for i := 0; i < 3; i++ {
+		if err := limiter.wait(context.Background(), sleep); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The first request is sent right away, the following ones are spaced by
+	// the interval.
+	if len(delays) != 2 {
+		t.Fatalf("expected 2 delays, got %v", delays)
+	}
+	if delays[0] <= 59*time.Second || delays[0] > time.Minute {
+		t.Errorf("unexpected first delay: %v", delays[0])
+	}
+	if delays[1] <= 119*time.Second || delays[1] > 2*time.Minute {
+		t.Errorf("unexpected second delay: %v", delays[1])
+	}
+}
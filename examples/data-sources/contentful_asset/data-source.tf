@@ -0,0 +1,8 @@
+data "contentful_asset" "example_asset" {
+  space_id = "space-id"
+  asset_id = "asset-id"
+}
+
+output "asset_url" {
+  value = data.contentful_asset.example_asset.fields[0].file[0].url
+}
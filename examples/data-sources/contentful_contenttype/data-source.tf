@@ -0,0 +1,5 @@
+data "contentful_contenttype" "example_contenttype" {
+  space_id        = "space-id"
+  env_id          = "master"
+  content_type_id = "content-type-id"
+}
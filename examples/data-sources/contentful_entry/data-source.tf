@@ -0,0 +1,5 @@
+data "contentful_entry" "example_entry" {
+  space_id = "space-id"
+  env_id   = "master"
+  entry_id = "entry-id"
+}
@@ -0,0 +1,4 @@
+data "contentful_environment" "example_environment" {
+  space_id       = "space-id"
+  environment_id = "master"
+}
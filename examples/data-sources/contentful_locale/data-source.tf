@@ -0,0 +1,4 @@
+data "contentful_locale" "example_locale" {
+  space_id = "space-id"
+  code     = "de-DE"
+}
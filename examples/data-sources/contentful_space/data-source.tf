@@ -0,0 +1,3 @@
+data "contentful_space" "example_space" {
+  name = "space-name"
+}
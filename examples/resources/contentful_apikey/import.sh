@@ -0,0 +1 @@
+terraform import contentful_apikey.myapikey space-id/apikey-id
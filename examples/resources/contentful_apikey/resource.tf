@@ -1,6 +1,12 @@
 resource "contentful_apikey" "myapikey" {
   space_id = "space-id"
 
-  name        = "api-key-name"
-  description = "a-great-key"
+  name         = "api-key-name"
+  description  = "a-great-key"
+  environments = ["master", "staging"]
+}
+
+output "preview_token" {
+  value     = contentful_apikey.myapikey.preview_token
+  sensitive = true
 }
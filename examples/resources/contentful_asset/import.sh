@@ -0,0 +1 @@
+terraform import contentful_asset.example_asset space-id/env-id/asset-id
@@ -0,0 +1 @@
+terraform import contentful_contenttype.example_contenttype space-id/env-id/content-type-id
@@ -30,4 +30,30 @@ resource "contentful_contenttype" "example_contenttype" {
     ]
     required = false
   }
+  field {
+    id   = "title"
+    name = "Title"
+    type = "Symbol"
+    validation {
+      size {
+        min = 1
+        max = 100
+      }
+      message = "The title has to be between 1 and 100 characters long."
+    }
+    validation {
+      unique = true
+    }
+  }
+  field {
+    id   = "tags"
+    name = "Tags"
+    type = "Array"
+    items {
+      type = "Symbol"
+      validation {
+        in = ["news", "blog"]
+      }
+    }
+  }
 }
@@ -0,0 +1 @@
+terraform import contentful_editor_interface.example_editor_interface space-id/env-id/content-type-id
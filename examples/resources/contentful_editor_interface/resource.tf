@@ -0,0 +1,38 @@
+resource "contentful_editor_interface" "example_editor_interface" {
+  space_id        = "space-id"
+  env_id          = "master"
+  content_type_id = contentful_contenttype.example_contenttype.id
+
+  controls {
+    field_id  = "title"
+    widget_id = "singleLine"
+    help_text = "The title is shown in the page header"
+  }
+
+  controls {
+    field_id  = "slug"
+    widget_id = "slugEditor"
+    settings = jsonencode({
+      trackingFieldId = "title"
+    })
+  }
+
+  sidebar {
+    widget_id = "publication-widget"
+  }
+
+  editor_layout {
+    group_id = "content"
+    name     = "Content"
+
+    item {
+      field_id = "title"
+    }
+
+    item {
+      group_id  = "seo"
+      name      = "SEO"
+      field_ids = ["slug"]
+    }
+  }
+}
@@ -0,0 +1 @@
+terraform import contentful_entry.example_entry space-id/env-id/entry-id
@@ -13,7 +13,54 @@ resource "contentful_entry" "example_entry" {
     content = "Lettuce is healthy!"
     locale  = "en-US"
   }
+  field {
+    id           = "rating"
+    locale       = "en-US"
+    content_json = jsonencode(5)
+  }
+  field {
+    id           = "body"
+    locale       = "en-US"
+    content_json = jsonencode({
+      nodeType = "document"
+      data     = {}
+      content = [{
+        nodeType = "paragraph"
+        data     = {}
+        content  = [{ nodeType = "text", value = "Rich text", marks = [], data = {} }]
+      }]
+    })
+  }
   published  = false
   archived   = false
   depends_on = [contentful_contenttype.mycontenttype]
 }
+
+resource "contentful_entry" "example_reference" {
+  entry_id       = "mytestreference"
+  space_id       = "space-id"
+  contenttype_id = "type-id"
+  locale         = "en-US"
+  field {
+    id     = "parent"
+    locale = "en-US"
+    link {
+      link_type = "Entry"
+      id        = contentful_entry.example_entry.id
+    }
+  }
+  field {
+    id     = "images"
+    locale = "en-US"
+    links {
+      link_type = "Asset"
+      id        = "asset-id-1"
+    }
+    links {
+      link_type = "Asset"
+      id        = "asset-id-2"
+    }
+  }
+  published = false
+  archived  = false
+}
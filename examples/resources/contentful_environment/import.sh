@@ -0,0 +1 @@
+terraform import contentful_environment.example_environment space-id/environment-id
@@ -2,3 +2,15 @@ resource "contentful_environment" "example_environment" {
   space_id = "spaced-id"
   name     = "environment-name"
 }
+
+# Clone an existing environment. Creation waits until all content has been
+# copied, so resources referencing the environment can be created right away.
+resource "contentful_environment" "staging" {
+  space_id              = "spaced-id"
+  name                  = "staging"
+  source_environment_id = contentful_environment.example_environment.id
+
+  timeouts {
+    create = "30m"
+  }
+}
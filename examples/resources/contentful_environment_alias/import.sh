@@ -0,0 +1 @@
+terraform import contentful_environment_alias.master space-id/alias-id
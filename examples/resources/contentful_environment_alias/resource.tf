@@ -0,0 +1,13 @@
+resource "contentful_environment" "release" {
+  space_id              = "space-id"
+  name                  = "release-2024-01"
+  source_environment_id = "master"
+}
+
+# Point master to the new release. Changing environment_id swaps the alias
+# without recreating it.
+resource "contentful_environment_alias" "master" {
+  space_id       = "space-id"
+  alias_id       = "master"
+  environment_id = contentful_environment.release.id
+}
@@ -0,0 +1 @@
+terraform import contentful_locale.example_locale space-id/env-id/locale-id
@@ -1,5 +1,6 @@
 resource "contentful_locale" "example_locale" {
   space_id = "spaced-id"
+  env_id   = "env-id"
 
   name          = "locale-name"
   code          = "de"
@@ -0,0 +1 @@
+terraform import contentful_role.editor space-id/role-id
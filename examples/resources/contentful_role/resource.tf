@@ -0,0 +1,30 @@
+resource "contentful_role" "editor" {
+  space_id = "space-id"
+
+  name        = "Blog editor"
+  description = "Edits blog posts, but can't publish them"
+
+  permissions {
+    content_model = ["read"]
+    tags          = ["all"]
+  }
+
+  policy {
+    effect  = "allow"
+    actions = ["all"]
+    constraint = jsonencode({
+      and = [
+        { equals = [{ doc = "sys.type" }, "Entry"] },
+        { equals = [{ doc = "sys.contentType.sys.id" }, "post"] },
+      ]
+    })
+  }
+
+  policy {
+    effect  = "deny"
+    actions = ["publish", "unpublish"]
+    constraint = jsonencode({
+      equals = [{ doc = "sys.type" }, "Entry"]
+    })
+  }
+}
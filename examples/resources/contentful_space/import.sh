@@ -0,0 +1 @@
+terraform import contentful_space.example_space space-id
@@ -0,0 +1 @@
+terraform import contentful_space_membership.editor space-id/membership-id
@@ -0,0 +1,13 @@
+resource "contentful_space_membership" "editor" {
+  space_id = "space-id"
+
+  email    = "editor@example.com"
+  role_ids = [contentful_role.editor.id]
+}
+
+resource "contentful_space_membership" "admin" {
+  space_id = "space-id"
+
+  user_id = "user-id"
+  admin   = true
+}
@@ -0,0 +1 @@
+terraform import contentful_team_space_membership.editors space-id/membership-id
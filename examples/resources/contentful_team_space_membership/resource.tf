@@ -0,0 +1,6 @@
+resource "contentful_team_space_membership" "editors" {
+  space_id = "space-id"
+
+  team_id  = "team-id"
+  role_ids = [contentful_role.editor.id]
+}
@@ -0,0 +1 @@
+terraform import contentful_webhook.example_webhook space-id/webhook-id
@@ -11,6 +11,28 @@ resource "contentful_webhook" "example_webhook" {
     header1 = "header1-value"
     header2 = "header2-value"
   }
+  secret_headers = {
+    Authorization = "Bearer secret-token"
+  }
   http_basic_auth_username = "username"
   http_basic_auth_password = "password"
+
+  filter {
+    doc    = "sys.environment.sys.id"
+    equals = "master"
+  }
+  filter {
+    doc = "sys.contentType.sys.id"
+    in  = ["post", "page"]
+    not = true
+  }
+
+  transformation {
+    method                 = "PUT"
+    content_type           = "application/json"
+    include_content_length = true
+    body = jsonencode({
+      id = "{ /payload/sys/id }"
+    })
+  }
 }